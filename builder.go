@@ -0,0 +1,48 @@
+package slice
+
+// Builder builds a slice incrementally,
+// like strings.Builder does for strings.
+// Since each Append returns a new slice,
+// a loop of Appends must reassign its result every time;
+// a Builder does that bookkeeping itself.
+// The zero value is an empty Builder ready to use.
+type Builder[T any] struct {
+	items *Slice[T]
+}
+
+// Add appends items to the slice being built.
+func (b *Builder[T]) Add(items ...T) {
+	if b.items == nil {
+		// Append would use items itself as the result's storage.
+		b.items = fromCopy(items)
+		return
+	}
+	b.items = b.items.Append(items...)
+}
+
+// Grow makes sure there is room for at least n more elements
+// without another reallocation.
+func (b *Builder[T]) Grow(n int) {
+	if n < 0 {
+		panic("growth must not be negative")
+	}
+	if b.items.Cap()-b.items.Len() >= n {
+		return
+	}
+	items := Make[T](b.items.Len(), b.items.Len()+n)
+	b.items.Copy(items)
+	b.items = items
+}
+
+// Len is the number of elements added so far.
+func (b *Builder[T]) Len() int {
+	return b.items.Len()
+}
+
+// Build returns the slice built so far.
+// It is clipped (see [Slice.Clip]),
+// so further Adds to b won't be visible in it,
+// and Appends to it won't disturb b.
+func (b *Builder[T]) Build() *Slice[T] {
+	return b.items.Clip()
+}
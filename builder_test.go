@@ -0,0 +1,71 @@
+package slice
+
+import "testing"
+
+func TestBuilder(t *testing.T) {
+	var b Builder[int]
+	b.Grow(1000)
+	first := b.items
+
+	for i := 0; i < 1000; i++ {
+		b.Add(i)
+		if !b.items.SharesStorageWith(first) {
+			t.Fatalf("reallocated after %d elements", i+1)
+		}
+	}
+	if l := b.Len(); l != 1000 {
+		t.Errorf("got %d, want 1000", l)
+	}
+
+	s := b.Build()
+	if l := s.Len(); l != 1000 {
+		t.Errorf("got %d, want 1000", l)
+	}
+	if c := s.Cap(); c != 1000 {
+		t.Errorf("got %d, want 1000", c)
+	}
+	for i := 0; i < s.Len(); i++ {
+		if v := s.At(i); v != i {
+			t.Fatalf("element %d is %d", i, v)
+		}
+	}
+}
+
+func TestBuilderGrowth(t *testing.T) {
+	var (
+		b        Builder[int]
+		reallocs int
+	)
+	for i := 0; i < 1000; i++ {
+		prev := b.items
+		b.Add(i)
+		if !b.items.SharesStorageWith(prev) {
+			reallocs++
+		}
+	}
+	if reallocs > 10 {
+		t.Errorf("got %d reallocations, want at most 10", reallocs)
+	}
+
+	s := b.Build()
+	b.Add(1000)
+	if l := s.Len(); l != 1000 {
+		t.Errorf("got %d, want 1000", l)
+	}
+	if c := s.Cap(); c != 1000 {
+		t.Errorf("got %d, want 1000", c)
+	}
+}
+
+func TestBuilderCopiesItems(t *testing.T) {
+	var (
+		b   Builder[int]
+		buf = []int{1, 2, 3}
+	)
+	b.Add(buf...)
+	built := b.Build()
+	buf[0] = 99
+	if v := built.At(0); v != 1 {
+		t.Errorf("got %d, want 1", v)
+	}
+}
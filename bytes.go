@@ -0,0 +1,50 @@
+package slice
+
+// FromString is like []byte(str).
+// The result has its own copy of the bytes;
+// it does not alias the string's (immutable) storage.
+func FromString(str string) *Slice[byte] {
+	if str == "" {
+		return nil
+	}
+	return FromArray([]byte(str))
+}
+
+// Bytes returns a copy of the elements of s as an intrinsic Go byte slice.
+//
+// This (and String) would be methods on *Slice[byte],
+// but Go doesn't allow methods on only some instantiations of a generic type.
+func Bytes(s *Slice[byte]) []byte {
+	if s.Len() == 0 {
+		return nil
+	}
+	result := make([]byte, s.Len())
+	copy(result, s.storage[s.offset:s.offset+s.length])
+	return result
+}
+
+// String is like string(s) for a byte slice s.
+func String(s *Slice[byte]) string {
+	if s == nil {
+		return ""
+	}
+	return string(s.storage[s.offset : s.offset+s.length])
+}
+
+// Runes is like []rune(str).
+// Indexing the result selects Unicode code points,
+// not bytes as when indexing str itself.
+func Runes(str string) *Slice[rune] {
+	if str == "" {
+		return nil
+	}
+	return FromArray([]rune(str))
+}
+
+// RuneString is like string(s) for a rune slice s.
+func RuneString(s *Slice[rune]) string {
+	if s == nil {
+		return ""
+	}
+	return string(s.storage[s.offset : s.offset+s.length])
+}
@@ -0,0 +1,67 @@
+package slice
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestFromString(t *testing.T) {
+	for _, str := range []string{"", "hello", "héllo, 世界"} {
+		s := FromString(str)
+		if l := s.Len(); l != len(str) {
+			t.Errorf("%q: got length %d, want %d", str, l, len(str))
+		}
+		if got := String(s); got != str {
+			t.Errorf("got %q, want %q", got, str)
+		}
+		if got := Bytes(s); !bytes.Equal(got, []byte(str)) {
+			t.Errorf("got %q, want %q", got, str)
+		}
+	}
+
+	var (
+		str = "hello"
+		s   = FromString(str)
+	)
+	*s.AtPtr(0) = 'j'
+	if got := String(s); got != "jello" {
+		t.Errorf(`got %q, want "jello"`, got)
+	}
+	if str != "hello" {
+		t.Errorf(`original string changed to %q`, str)
+	}
+
+	b := Bytes(s)
+	b[0] = 'c'
+	if got := String(s); got != "jello" {
+		t.Errorf(`Bytes result aliases s; got %q, want "jello"`, got)
+	}
+
+	if got := String(FromString("hello, world").Subslice(7, 12)); got != "world" {
+		t.Errorf(`got %q, want "world"`, got)
+	}
+}
+
+func TestRunes(t *testing.T) {
+	// "e" followed by a combining acute accent, then an emoji.
+	str := "ae\u0301\U0001F642z"
+
+	s := Runes(str)
+	if l := s.Len(); l != 5 {
+		t.Errorf("got %d, want 5", l)
+	}
+	for i, want := range []rune{'a', 'e', '\u0301', '🙂', 'z'} {
+		if r := s.At(i); r != want {
+			t.Errorf("rune %d: got %q, want %q", i, r, want)
+		}
+	}
+	if got := RuneString(s); got != str {
+		t.Errorf("got %q, want %q", got, str)
+	}
+	if got := RuneString(s.Subslice(3, 5)); got != "🙂z" {
+		t.Errorf(`got %q, want "🙂z"`, got)
+	}
+	if got := RuneString(Runes("")); got != "" {
+		t.Errorf(`got %q, want ""`, got)
+	}
+}
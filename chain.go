@@ -0,0 +1,64 @@
+package slice
+
+// Chain wraps a slice so that operations on it can be strung together:
+//
+//	result := Start(s).Filter(pred).Apply(f).Take(10).Collect()
+//
+// Go methods can't have type parameters of their own,
+// so every step in a chain keeps the same element type T.
+//
+// Chains are eager:
+// each step does all its work immediately
+// and passes the resulting slice to the next.
+// Steps never modify the slice given to Start,
+// but Take and Drop (like Subslice) share storage with their input,
+// so the result of Collect may do so too.
+type Chain[T any] struct {
+	s *Slice[T]
+}
+
+// Start begins a chain of operations on s.
+func Start[T any](s *Slice[T]) Chain[T] {
+	return Chain[T]{s: s}
+}
+
+// Filter keeps only the elements satisfying pred.
+func (c Chain[T]) Filter(pred func(T) bool) Chain[T] {
+	var result *Slice[T]
+	for i := 0; i < c.s.Len(); i++ {
+		if v := c.s.At(i); pred(v) {
+			result = result.Append(v)
+		}
+	}
+	return Chain[T]{s: result}
+}
+
+// Apply replaces each element v with f(v).
+func (c Chain[T]) Apply(f func(T) T) Chain[T] {
+	n := c.s.Len()
+	if n == 0 {
+		return Chain[T]{}
+	}
+	result := Make[T](n, n)
+	for i := 0; i < n; i++ {
+		result.storage[i] = f(c.s.At(i))
+	}
+	return Chain[T]{s: result}
+}
+
+// Take keeps only the first n elements
+// (or all of them, if there are fewer than n).
+func (c Chain[T]) Take(n int) Chain[T] {
+	return Chain[T]{s: c.s.DropLast(c.s.Len() - n)}
+}
+
+// Drop discards the first n elements
+// (or all of them, if there are fewer than n).
+func (c Chain[T]) Drop(n int) Chain[T] {
+	return Chain[T]{s: c.s.TakeLast(c.s.Len() - n)}
+}
+
+// Collect ends the chain and returns the resulting slice.
+func (c Chain[T]) Collect() *Slice[T] {
+	return c.s
+}
@@ -0,0 +1,46 @@
+package slice
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	s := Iterate(1, 10, func(x int) int { return x + 1 })
+
+	got := Start(s).
+		Filter(func(x int) bool { return x%2 == 1 }).
+		Apply(func(x int) int { return x * x }).
+		Drop(1).
+		Take(3).
+		Collect()
+	if want := []int{9, 25, 49}; !slices.Equal(elems(got), want) {
+		t.Errorf("got %v, want %v", elems(got), want)
+	}
+
+	if want := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}; !slices.Equal(elems(s), want) {
+		t.Errorf("input changed to %v", elems(s))
+	}
+
+	if got := Start(s).Take(20).Drop(8).Collect(); !slices.Equal(elems(got), []int{9, 10}) {
+		t.Errorf("got %v, want [9 10]", elems(got))
+	}
+	if got := Start(s).Drop(20).Collect(); got.Len() != 0 {
+		t.Errorf("got %v, want []", elems(got))
+	}
+}
+
+func TestChainEager(t *testing.T) {
+	calls := 0
+	c := Start(From(1, 2, 3)).Apply(func(x int) int {
+		calls++
+		return x
+	})
+	if calls != 3 {
+		t.Errorf("got %d calls before Collect, want 3", calls)
+	}
+	c.Collect()
+	if calls != 3 {
+		t.Errorf("got %d calls after Collect, want 3", calls)
+	}
+}
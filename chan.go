@@ -0,0 +1,51 @@
+package slice
+
+import "context"
+
+// ToChan returns a channel that produces the elements of s in order
+// and is then closed.
+// If ctx is canceled before all the elements have been received,
+// the channel is closed early
+// (and the goroutine feeding it exits).
+func (s *Slice[T]) ToChan(ctx context.Context) <-chan T {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		for i := 0; i < s.Len(); i++ {
+			// When both cases below are ready,
+			// select chooses at random,
+			// so check for cancellation first.
+			if ctx.Err() != nil {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- s.At(i):
+			}
+		}
+	}()
+	return ch
+}
+
+// FromChan collects the values received on ch into a new slice,
+// growing it with Append.
+// It stops when ch is closed or ctx is canceled,
+// and returns whatever it has collected by then.
+func FromChan[T any](ctx context.Context, ch <-chan T) *Slice[T] {
+	var result *Slice[T]
+	for {
+		if ctx.Err() != nil {
+			return result
+		}
+		select {
+		case <-ctx.Done():
+			return result
+		case v, ok := <-ch:
+			if !ok {
+				return result
+			}
+			result = result.Append(v)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package slice
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestToChan(t *testing.T) {
+	ctx := context.Background()
+
+	var got []int
+	for v := range From(1, 2, 3, 4).ToChan(ctx) {
+		got = append(got, v)
+	}
+	if !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("got %v, want [1 2 3 4]", got)
+	}
+
+	for range (*Slice[int])(nil).ToChan(ctx) {
+		t.Error("nil slice should produce nothing")
+	}
+}
+
+func TestToChanCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch := Iterate(0, 1000, func(x int) int { return x + 1 }).ToChan(ctx)
+	for i := 0; i < 3; i++ {
+		if v := <-ch; v != i {
+			t.Errorf("got %d, want %d", v, i)
+		}
+	}
+	cancel()
+
+	// The channel is closed when the producer exits.
+	// At most one more element may get through before it notices the cancellation.
+	n := 0
+	for range ch {
+		n++
+	}
+	if n > 1 {
+		t.Errorf("got %d more elements after cancellation, want at most 1", n)
+	}
+}
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for i := 1; i <= 4; i++ {
+			ch <- i
+		}
+	}()
+	got := FromChan(context.Background(), ch)
+	if !slices.Equal(elems(got), []int{1, 2, 3, 4}) {
+		t.Errorf("got %v, want [1 2 3 4]", elems(got))
+	}
+}
+
+func TestFromChanCancel(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		ch          = make(chan int)
+		done        = make(chan struct{})
+	)
+	defer cancel()
+
+	// This producer sends two values and then abandons the channel without closing it.
+	go func() {
+		ch <- 1
+		ch <- 2
+		cancel()
+		close(done)
+	}()
+
+	got := FromChan(ctx, ch)
+	<-done
+	if !slices.Equal(elems(got), []int{1, 2}) {
+		t.Errorf("got %v, want [1 2]", elems(got))
+	}
+}
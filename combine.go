@@ -0,0 +1,58 @@
+package slice
+
+// Interleave returns a new slice
+// taking one element from each of the given slices in turn:
+// the first element of each, then the second element of each, and so on.
+// Slices that run out are skipped
+// until all of them are exhausted.
+func Interleave[T any](slices ...*Slice[T]) *Slice[T] {
+	var n, longest int
+	for _, s := range slices {
+		n += s.Len()
+		longest = max(longest, s.Len())
+	}
+	if n == 0 {
+		return nil
+	}
+
+	result := Make[T](0, n)
+	for i := 0; i < longest; i++ {
+		for _, s := range slices {
+			if v, ok := s.TryAt(i); ok {
+				result = result.Append(v)
+			}
+		}
+	}
+	return result
+}
+
+// Transpose treats rows as a matrix, one slice per row,
+// and returns its transpose:
+// element [i][j] of the input becomes element [j][i] of the output.
+//
+// If the rows have different lengths,
+// the shorter ones are treated as if padded with zero values
+// to the length of the longest.
+// So the output has one row for each column of the longest input row,
+// and each output row has rows.Len() elements.
+func Transpose[T any](rows *Slice[*Slice[T]]) *Slice[*Slice[T]] {
+	var cols int
+	for i := 0; i < rows.Len(); i++ {
+		cols = max(cols, rows.At(i).Len())
+	}
+	if cols == 0 {
+		return nil
+	}
+
+	result := Make[*Slice[T]](cols, cols)
+	for j := 0; j < cols; j++ {
+		col := Make[T](rows.Len(), rows.Len())
+		for i := 0; i < rows.Len(); i++ {
+			if v, ok := rows.At(i).TryAt(j); ok {
+				col.storage[i] = v
+			}
+		}
+		result.storage[j] = col
+	}
+	return result
+}
@@ -0,0 +1,60 @@
+package slice
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestInterleave(t *testing.T) {
+	got := Interleave(From(1, 2, 3), From(10, 20, 30), From(100, 200, 300))
+	if want := []int{1, 10, 100, 2, 20, 200, 3, 30, 300}; !slices.Equal(elems(got), want) {
+		t.Errorf("got %v, want %v", elems(got), want)
+	}
+
+	got = Interleave(From(1), From(10, 20, 30), nil, From(100, 200))
+	if want := []int{1, 10, 100, 20, 200, 30}; !slices.Equal(elems(got), want) {
+		t.Errorf("got %v, want %v", elems(got), want)
+	}
+
+	if l := Interleave[int]().Len(); l != 0 {
+		t.Errorf("got %d, want 0", l)
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	cases := []struct {
+		name       string
+		rows, want [][]int
+	}{{
+		name: "square",
+		rows: [][]int{{1, 2}, {3, 4}},
+		want: [][]int{{1, 3}, {2, 4}},
+	}, {
+		name: "rectangular",
+		rows: [][]int{{1, 2, 3}, {4, 5, 6}},
+		want: [][]int{{1, 4}, {2, 5}, {3, 6}},
+	}, {
+		name: "ragged",
+		rows: [][]int{{1, 2, 3}, {4}, {5, 6}},
+		want: [][]int{{1, 4, 5}, {2, 0, 6}, {3, 0, 0}},
+	}, {
+		name: "empty",
+	}}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var rows *Slice[*Slice[int]]
+			for _, row := range c.rows {
+				rows = rows.Append(FromArray(row))
+			}
+			got := Transpose(rows)
+			if l := got.Len(); l != len(c.want) {
+				t.Fatalf("got %d rows, want %d", l, len(c.want))
+			}
+			for i, want := range c.want {
+				if row := elems(got.At(i)); !slices.Equal(row, want) {
+					t.Errorf("row %d: got %v, want %v", i, row, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package slice
+
+import (
+	"cmp"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+)
+
+// Compare compares the elements of a and b in order,
+// like slices.Compare.
+// The result is determined by the first pair of elements that differ;
+// if there is none,
+// the shorter slice is less.
+// It returns -1 if a is less than b,
+// 0 if they are equal,
+// and 1 if a is greater than b.
+// A nil slice compares equal to an empty one.
+func Compare[T cmp.Ordered](a, b *Slice[T]) int {
+	return CompareFunc(a, b, cmp.Compare[T])
+}
+
+// CompareFunc is like Compare but compares elements with the function cmp,
+// which returns a negative number, zero, or a positive number.
+// The result of CompareFunc is still -1, 0, or 1.
+func CompareFunc[T any](a, b *Slice[T], cmp func(T, T) int) int {
+	for i := 0; i < a.Len() && i < b.Len(); i++ {
+		switch c := cmp(a.At(i), b.At(i)); {
+		case c < 0:
+			return -1
+		case c > 0:
+			return 1
+		}
+	}
+	switch {
+	case a.Len() < b.Len():
+		return -1
+	case a.Len() > b.Len():
+		return 1
+	}
+	return 0
+}
+
+// EqualUnordered tells whether a and b contain the same elements,
+// each the same number of times,
+// regardless of order.
+func EqualUnordered[T comparable](a, b *Slice[T]) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+
+	counts := make(map[T]int)
+	for i := 0; i < a.Len(); i++ {
+		counts[a.At(i)]++
+	}
+	for i := 0; i < b.Len(); i++ {
+		v := b.At(i)
+		if counts[v] == 0 {
+			return false
+		}
+		counts[v]--
+	}
+	return true
+}
+
+// Hash computes a hash of the elements of s, in order,
+// such that slices with equal elements hash equally.
+// The order of the elements matters,
+// as does their number.
+// A nil slice hashes the same as an empty one.
+//
+// The hash is 64-bit FNV-1a,
+// applied to the Go-syntax representation (%#v) of each element,
+// so it is the same from one run of the program to the next
+// (unless the elements contain pointers).
+// Since %#v writes negative zero as -0,
+// float and complex elements are normalized first,
+// so that 0 and -0 (which are ==) hash equally.
+func Hash[T comparable](s *Slice[T]) uint64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d:", s.Len())
+	for i := 0; i < s.Len(); i++ {
+		// Prefix each element with its length
+		// so the boundaries between elements are unambiguous.
+		repr := fmt.Sprintf("%#v", canonicalZero(s.At(i)))
+		fmt.Fprintf(h, "%d:%s", len(repr), repr)
+	}
+	return h.Sum64()
+}
+
+// canonicalZero returns v as an any,
+// turning a negative floating-point zero into a positive one.
+// Other values are unchanged.
+func canonicalZero[T any](v T) any {
+	switch x := any(v).(type) {
+	case float32:
+		if x == 0 {
+			return float32(0)
+		}
+	case float64:
+		if x == 0 {
+			return float64(0)
+		}
+	case complex64:
+		return complex(canonicalZero(real(x)).(float32), canonicalZero(imag(x)).(float32))
+	case complex128:
+		return complex(canonicalZero(real(x)).(float64), canonicalZero(imag(x)).(float64))
+	}
+	return v
+}
+
+// EqualWithin tells whether a and b have the same length
+// and each pair of corresponding elements differs by no more than epsilon.
+// A NaN element is not within any distance of anything,
+// including another NaN.
+// An infinite element is within any distance of an equal infinity.
+func EqualWithin[T ~float32 | ~float64](a, b *Slice[T], epsilon T) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	for i := 0; i < a.Len(); i++ {
+		if a.At(i) == b.At(i) {
+			// Needed for infinities, whose difference is NaN.
+			continue
+		}
+		// Written this way (rather than as a test for > epsilon)
+		// so that a NaN difference counts as unequal.
+		if !(math.Abs(float64(a.At(i)-b.At(i))) <= float64(epsilon)) {
+			return false
+		}
+	}
+	return true
+}
+
+// ElementsMatch is like EqualUnordered,
+// but when a and b don't match,
+// it also returns a message describing the difference,
+// for use in test failures.
+// The message lists the elements in a that are not in b ("extra")
+// and the elements in b that are not in a ("missing"),
+// counting multiplicity.
+// When a and b match, the message is empty.
+func ElementsMatch[T comparable](a, b *Slice[T]) (bool, string) {
+	counts := make(map[T]int)
+	for i := 0; i < b.Len(); i++ {
+		counts[b.At(i)]++
+	}
+
+	var extra, missing []T
+	for i := 0; i < a.Len(); i++ {
+		v := a.At(i)
+		if counts[v] > 0 {
+			counts[v]--
+		} else {
+			extra = append(extra, v)
+		}
+	}
+	for i := 0; i < b.Len(); i++ {
+		// Whatever is left in counts is missing from a.
+		// Report it in the order it appears in b.
+		v := b.At(i)
+		if counts[v] > 0 {
+			counts[v]--
+			missing = append(missing, v)
+		}
+	}
+
+	if len(extra) == 0 && len(missing) == 0 {
+		return true, ""
+	}
+
+	var parts []string
+	if len(extra) > 0 {
+		parts = append(parts, fmt.Sprintf("extra elements in a: %v", extra))
+	}
+	if len(missing) > 0 {
+		parts = append(parts, fmt.Sprintf("elements missing from a: %v", missing))
+	}
+	return false, strings.Join(parts, "; ")
+}
@@ -0,0 +1,159 @@
+package slice
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		a, b []int
+		want int
+	}{
+		{a: []int{1, 2, 3}, b: []int{1, 2, 3}, want: 0},
+		{a: []int{1, 2}, b: []int{1, 2, 3}, want: -1},
+		{a: []int{1, 2, 3}, b: []int{1, 2}, want: 1},
+		{a: []int{1, 5}, b: []int{1, 2, 3}, want: 1},
+		{a: []int{1, 2, 3}, b: []int{1, 3}, want: -1},
+		{a: nil, b: []int{}, want: 0},
+		{a: nil, b: []int{1}, want: -1},
+	}
+	for _, c := range cases {
+		if got := Compare(FromArray(c.a), FromArray(c.b)); got != c.want {
+			t.Errorf("Compare(%v, %v): got %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+	if got := Compare(nil, From(1)); got != -1 {
+		t.Errorf("got %d, want -1", got)
+	}
+}
+
+func TestCompareFunc(t *testing.T) {
+	var (
+		a = From("A", "b")
+		b = From("a", "B", "c")
+	)
+	got := CompareFunc(a, b, func(x, y string) int {
+		return strings.Compare(strings.ToLower(x), strings.ToLower(y))
+	})
+	if got != -1 {
+		t.Errorf("got %d, want -1", got)
+	}
+}
+
+func TestEqualUnordered(t *testing.T) {
+	cases := []struct {
+		a, b []string
+		want bool
+	}{
+		{a: []string{"a", "b", "c"}, b: []string{"c", "a", "b"}, want: true},
+		{a: []string{"a", "a", "b"}, b: []string{"a", "b", "a"}, want: true},
+		{a: []string{"a", "a", "b"}, b: []string{"a", "b", "b"}, want: false},
+		{a: []string{"a", "b"}, b: []string{"a", "b", "b"}, want: false},
+		{a: nil, b: []string{}, want: true},
+	}
+	for _, c := range cases {
+		if got := EqualUnordered(FromArray(c.a), FromArray(c.b)); got != c.want {
+			t.Errorf("EqualUnordered(%v, %v): got %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestHash(t *testing.T) {
+	var (
+		a = From("x", "y", "z")
+		b = From("w", "x", "y", "z").Subslice(1, 4)
+		c = From("z", "y", "x")
+	)
+	if Hash(a) != Hash(b) {
+		t.Error("equal contents should hash equally")
+	}
+	if Hash(a) == Hash(c) {
+		t.Error("reordered contents should hash differently")
+	}
+	if Hash(From("ab", "c")) == Hash(From("a", "bc")) {
+		t.Error("element boundaries should matter")
+	}
+	if Hash(From(1, 2)) == Hash(From(1, 2, 0)) {
+		t.Error("length should matter")
+	}
+	if Hash[int](nil) != Hash(Make[int](0, 5)) {
+		t.Error("nil and empty should hash equally")
+	}
+
+	negZero := math.Copysign(0, -1)
+	if Hash(From(0.0)) != Hash(From(negZero)) {
+		t.Error("0 and -0 should hash equally")
+	}
+	if Hash(From(complex(0, 0))) != Hash(From(complex(negZero, negZero))) {
+		t.Error("complex 0 and -0 should hash equally")
+	}
+	if Hash(From(float32(0))) != Hash(From(float32(negZero))) {
+		t.Error("float32 0 and -0 should hash equally")
+	}
+}
+
+func TestEqualWithin(t *testing.T) {
+	var (
+		nan = math.NaN()
+		inf = math.Inf(1)
+	)
+	cases := []struct {
+		a, b []float64
+		want bool
+	}{
+		{a: []float64{1, 2, 3}, b: []float64{1.05, 1.95, 3}, want: true},
+		{a: []float64{1, 2, 3}, b: []float64{1.2, 2, 3}, want: false},
+		{a: []float64{1, 2}, b: []float64{1, 2, 3}, want: false},
+		{a: []float64{1, nan}, b: []float64{1, nan}, want: false},
+		{a: []float64{1, nan}, b: []float64{1, 2}, want: false},
+		{a: []float64{1, inf}, b: []float64{1, inf}, want: true},
+		{a: []float64{1, inf}, b: []float64{1, -inf}, want: false},
+		{a: []float64{1, inf}, b: []float64{1, 2}, want: false},
+		{a: nil, b: []float64{}, want: true},
+	}
+	for _, c := range cases {
+		if got := EqualWithin(FromArray(c.a), FromArray(c.b), 0.1); got != c.want {
+			t.Errorf("EqualWithin(%v, %v): got %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+
+	if !EqualWithin(From[float32](1, 2), From[float32](1.5, 2), 0.5) {
+		t.Error("a difference equal to epsilon should count as equal")
+	}
+}
+
+func TestElementsMatch(t *testing.T) {
+	cases := []struct {
+		a, b    []string
+		wantOK  bool
+		wantMsg string
+	}{{
+		a:      []string{"a", "b", "b", "c"},
+		b:      []string{"b", "c", "a", "b"},
+		wantOK: true,
+	}, {
+		a:       []string{"a", "b", "x", "b"},
+		b:       []string{"b", "a"},
+		wantMsg: "extra elements in a: [x b]",
+	}, {
+		a:       []string{"a"},
+		b:       []string{"a", "y", "z"},
+		wantMsg: "elements missing from a: [y z]",
+	}, {
+		a:       []string{"a", "x"},
+		b:       []string{"a", "y"},
+		wantMsg: "extra elements in a: [x]; elements missing from a: [y]",
+	}, {
+		a:      nil,
+		b:      []string{},
+		wantOK: true,
+	}}
+	for _, c := range cases {
+		ok, msg := ElementsMatch(FromArray(c.a), FromArray(c.b))
+		if ok != c.wantOK || msg != c.wantMsg {
+			t.Errorf("ElementsMatch(%v, %v): got %v, %q; want %v, %q", c.a, c.b, ok, msg, c.wantOK, c.wantMsg)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package slice
+
+import "fmt"
+
+// EditOp is the kind of operation in an Edit.
+type EditOp int
+
+const (
+	// Keep means the element appears in both sequences.
+	Keep EditOp = iota
+
+	// Insert means the element appears only in the second sequence.
+	Insert
+
+	// Delete means the element appears only in the first sequence.
+	Delete
+)
+
+func (op EditOp) String() string {
+	switch op {
+	case Keep:
+		return "Keep"
+	case Insert:
+		return "Insert"
+	case Delete:
+		return "Delete"
+	}
+	return fmt.Sprintf("EditOp(%d)", int(op))
+}
+
+// Edit is one step in an edit script produced by Diff.
+type Edit[T any] struct {
+	Op    EditOp
+	Value T
+}
+
+// Diff returns an edit script transforming a into b.
+// Reading a from the start,
+// each Keep step consumes the next element of a and also emits it,
+// each Delete step consumes the next element of a without emitting it,
+// and each Insert step emits a new element.
+// The emitted elements are b.
+//
+// The script is based on a longest common subsequence of a and b,
+// so it contains as many Keeps (and as few Inserts and Deletes) as possible.
+// It is computed with the classic dynamic-programming algorithm,
+// which takes O(a.Len() * b.Len()) time and space.
+func Diff[T comparable](a, b *Slice[T]) []Edit[T] {
+	var (
+		n, m = a.Len(), b.Len()
+
+		// lcs[i][j] is the length of a longest common subsequence
+		// of a[i:] and b[j:].
+		lcs = make([][]int, n+1)
+	)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a.At(i) == b.At(j) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var (
+		result []Edit[T]
+		i, j   int
+	)
+	for i < n && j < m {
+		switch {
+		case a.At(i) == b.At(j):
+			result = append(result, Edit[T]{Op: Keep, Value: a.At(i)})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			result = append(result, Edit[T]{Op: Delete, Value: a.At(i)})
+			i++
+		default:
+			result = append(result, Edit[T]{Op: Insert, Value: b.At(j)})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		result = append(result, Edit[T]{Op: Delete, Value: a.At(i)})
+	}
+	for ; j < m; j++ {
+		result = append(result, Edit[T]{Op: Insert, Value: b.At(j)})
+	}
+	return result
+}
@@ -0,0 +1,89 @@
+package slice
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b string
+		want []Edit[rune]
+	}{{
+		name: "identical",
+		a:    "abc",
+		b:    "abc",
+		want: []Edit[rune]{{Keep, 'a'}, {Keep, 'b'}, {Keep, 'c'}},
+	}, {
+		name: "insertion",
+		a:    "ac",
+		b:    "abc",
+		want: []Edit[rune]{{Keep, 'a'}, {Insert, 'b'}, {Keep, 'c'}},
+	}, {
+		name: "deletion",
+		a:    "abc",
+		b:    "bc",
+		want: []Edit[rune]{{Delete, 'a'}, {Keep, 'b'}, {Keep, 'c'}},
+	}, {
+		name: "mixed",
+		a:    "abcd",
+		b:    "axcy",
+		want: []Edit[rune]{{Keep, 'a'}, {Delete, 'b'}, {Insert, 'x'}, {Keep, 'c'}, {Delete, 'd'}, {Insert, 'y'}},
+	}, {
+		name: "empty",
+		a:    "",
+		b:    "ab",
+		want: []Edit[rune]{{Insert, 'a'}, {Insert, 'b'}},
+	}}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var (
+				a   = Runes(c.a)
+				b   = Runes(c.b)
+				got = Diff(a, b)
+			)
+			if !slices.Equal(got, c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+			if applied := RuneString(applyEdits(t, a, got)); applied != c.b {
+				t.Errorf("applying the edits to %q produced %q, want %q", c.a, applied, c.b)
+			}
+		})
+	}
+}
+
+func TestDiffApply(t *testing.T) {
+	a := From(1, 2, 3, 4, 5, 6, 7, 8)
+	b := From(2, 9, 4, 5, 1, 7, 8, 8, 3)
+	if got := elems(applyEdits(t, a, Diff(a, b))); !slices.Equal(got, elems(b)) {
+		t.Errorf("got %v, want %v", got, elems(b))
+	}
+}
+
+func applyEdits[T comparable](t *testing.T, a *Slice[T], edits []Edit[T]) *Slice[T] {
+	t.Helper()
+
+	var (
+		result *Slice[T]
+		i      int
+	)
+	for _, e := range edits {
+		switch e.Op {
+		case Keep, Delete:
+			if v := a.At(i); v != e.Value {
+				t.Fatalf("%v step at position %d expected %v, found %v", e.Op, i, e.Value, v)
+			}
+			i++
+			if e.Op == Keep {
+				result = result.Append(e.Value)
+			}
+		case Insert:
+			result = result.Append(e.Value)
+		}
+	}
+	if i != a.Len() {
+		t.Fatalf("edits consumed %d elements, want %d", i, a.Len())
+	}
+	return result
+}
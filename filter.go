@@ -0,0 +1,30 @@
+package slice
+
+// FilterPartition returns two new slices:
+// the elements of s satisfying pred,
+// and the ones that don't.
+// Both keep the elements in their original order.
+func FilterPartition[T any](s *Slice[T], pred func(T) bool) (kept, dropped *Slice[T]) {
+	for i := 0; i < s.Len(); i++ {
+		if v := s.At(i); pred(v) {
+			kept = kept.Append(v)
+		} else {
+			dropped = dropped.Append(v)
+		}
+	}
+	return kept, dropped
+}
+
+// MapFilter calls f on each element of s
+// and returns a new slice of the results for which f also returned true.
+// It combines a map and a filter in a single pass,
+// without an intermediate slice.
+func MapFilter[T, U any](s *Slice[T], f func(T) (U, bool)) *Slice[U] {
+	var result *Slice[U]
+	for i := 0; i < s.Len(); i++ {
+		if u, ok := f(s.At(i)); ok {
+			result = result.Append(u)
+		}
+	}
+	return result
+}
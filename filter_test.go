@@ -0,0 +1,41 @@
+package slice
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFilterPartition(t *testing.T) {
+	s := From(5, 2, 8, 1, 9, 4, 4)
+	kept, dropped := FilterPartition(s, func(x int) bool { return x%2 == 0 })
+	if got := elems(kept); !slices.Equal(got, []int{2, 8, 4, 4}) {
+		t.Errorf("got kept %v, want [2 8 4 4]", got)
+	}
+	if got := elems(dropped); !slices.Equal(got, []int{5, 1, 9}) {
+		t.Errorf("got dropped %v, want [5 1 9]", got)
+	}
+	if !EqualUnordered(dropped.AppendTo(kept), s) {
+		t.Error("kept and dropped together should have the same elements as s")
+	}
+
+	kept, dropped = FilterPartition(nil, func(int) bool { return true })
+	if kept.Len() != 0 || dropped.Len() != 0 {
+		t.Errorf("got %v and %v, want nothing", elems(kept), elems(dropped))
+	}
+}
+
+func TestMapFilter(t *testing.T) {
+	got := MapFilter(From("1", "x", "22", "", "333"), func(s string) (int, bool) {
+		if s == "" || s == "x" {
+			return 0, false
+		}
+		return len(s) * 10, true
+	})
+	if want := []int{10, 20, 30}; !slices.Equal(elems(got), want) {
+		t.Errorf("got %v, want %v", elems(got), want)
+	}
+
+	if got := MapFilter(nil, func(s string) (int, bool) { return 0, true }); got != nil {
+		t.Errorf("got %v, want nil", elems(got))
+	}
+}
@@ -0,0 +1,61 @@
+package slice
+
+// Iterate returns a new slice of length n
+// containing seed, f(seed), f(f(seed)), and so on.
+func Iterate[T any](seed T, n int, f func(T) T) *Slice[T] {
+	if n < 0 {
+		panic("length must not be negative")
+	}
+	if n == 0 {
+		return nil
+	}
+	result := Make[T](n, n)
+	result.storage[0] = seed
+	for i := 1; i < n; i++ {
+		result.storage[i] = f(result.storage[i-1])
+	}
+	return result
+}
+
+// Unfold builds a slice from a seed state.
+// It calls f on the state repeatedly.
+// Each call returns a value, the next state, and a boolean.
+// If the boolean is true, the value is appended to the result
+// and f is called again on the next state;
+// otherwise Unfold stops and returns the result.
+func Unfold[T, S any](seed S, f func(S) (T, S, bool)) *Slice[T] {
+	var (
+		result *Slice[T]
+		state  = seed
+	)
+	for {
+		v, next, ok := f(state)
+		if !ok {
+			return result
+		}
+		result = result.Append(v)
+		state = next
+	}
+}
+
+// Tabulate2D builds a matrix, represented as a slice of rows,
+// with the given numbers of rows and columns,
+// where the element in row r, column c is f(r, c).
+func Tabulate2D[T any](rows, cols int, f func(r, c int) T) *Slice[*Slice[T]] {
+	if rows < 0 || cols < 0 {
+		panic("dimensions must not be negative")
+	}
+	if rows == 0 {
+		return nil
+	}
+	result := Make[*Slice[T]](rows, rows)
+	for r := 0; r < rows; r++ {
+		var row *Slice[T]
+		if cols > 0 {
+			row = Make[T](cols, cols)
+			row.FillWith(func(c int) T { return f(r, c) })
+		}
+		result.storage[r] = row
+	}
+	return result
+}
@@ -0,0 +1,89 @@
+package slice
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestIterate(t *testing.T) {
+	got := Iterate(1, 6, func(x int) int { return 2 * x })
+	if !slices.Equal(elems(got), []int{1, 2, 4, 8, 16, 32}) {
+		t.Errorf("got %v, want [1 2 4 8 16 32]", elems(got))
+	}
+
+	collatz := func(x int) int {
+		if x%2 == 0 {
+			return x / 2
+		}
+		return 3*x + 1
+	}
+	got = Iterate(6, 9, collatz)
+	if !slices.Equal(elems(got), []int{6, 3, 10, 5, 16, 8, 4, 2, 1}) {
+		t.Errorf("got %v, want [6 3 10 5 16 8 4 2 1]", elems(got))
+	}
+
+	if l := Iterate(1, 0, collatz).Len(); l != 0 {
+		t.Errorf("got %d, want 0", l)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	Iterate(1, -1, collatz)
+}
+
+func TestUnfold(t *testing.T) {
+	fib := Unfold([2]int{0, 1}, func(st [2]int) (int, [2]int, bool) {
+		if st[0] > 50 {
+			return 0, st, false
+		}
+		return st[0], [2]int{st[1], st[0] + st[1]}, true
+	})
+	if want := []int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34}; !slices.Equal(elems(fib), want) {
+		t.Errorf("got %v, want %v", elems(fib), want)
+	}
+
+	empty := Unfold(0, func(int) (string, int, bool) { return "", 0, false })
+	if l := empty.Len(); l != 0 {
+		t.Errorf("got %d, want 0", l)
+	}
+}
+
+func TestTabulate2D(t *testing.T) {
+	table := Tabulate2D(3, 4, func(r, c int) int { return (r + 1) * (c + 1) })
+	want := [][]int{
+		{1, 2, 3, 4},
+		{2, 4, 6, 8},
+		{3, 6, 9, 12},
+	}
+	if l := table.Len(); l != len(want) {
+		t.Fatalf("got %d rows, want %d", l, len(want))
+	}
+	for r, w := range want {
+		if got := elems(table.At(r)); !slices.Equal(got, w) {
+			t.Errorf("row %d: got %v, want %v", r, got, w)
+		}
+	}
+
+	if l := Tabulate2D(0, 4, func(r, c int) int { return 0 }).Len(); l != 0 {
+		t.Errorf("got %d rows, want 0", l)
+	}
+	table = Tabulate2D(2, 0, func(r, c int) int { return 0 })
+	if l := table.Len(); l != 2 {
+		t.Errorf("got %d rows, want 2", l)
+	}
+	for r := 0; r < table.Len(); r++ {
+		if l := table.At(r).Len(); l != 0 {
+			t.Errorf("row %d: got %d columns, want 0", r, l)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	Tabulate2D(-1, 2, func(r, c int) int { return 0 })
+}
@@ -0,0 +1,282 @@
+package slice
+
+import "iter"
+
+// Pairwise returns an iterator over the adjacent pairs of elements in s:
+// (s[0], s[1]), (s[1], s[2]), and so on.
+// A slice with n elements yields n-1 pairs.
+func Pairwise[T any](s *Slice[T]) iter.Seq2[T, T] {
+	return func(yield func(T, T) bool) {
+		for i := 0; i+1 < s.Len(); i++ {
+			if !yield(s.At(i), s.At(i+1)) {
+				return
+			}
+		}
+	}
+}
+
+// Permutations returns an iterator over all orderings of the elements of s.
+// Each one is yielded as a new slice.
+// An empty s yields a single, empty permutation.
+//
+// This uses Heap's algorithm,
+// which produces each permutation from the previous one with a single swap.
+func Permutations[T any](s *Slice[T]) iter.Seq[*Slice[T]] {
+	return func(yield func(*Slice[T]) bool) {
+		var (
+			n    = s.Len()
+			perm = make([]T, n)
+			c    = make([]int, n)
+		)
+		if n > 0 {
+			copy(perm, s.storage[s.offset:s.offset+n])
+		}
+
+		if !yield(fromCopy(perm)) {
+			return
+		}
+		for i := 1; i < n; {
+			if c[i] >= i {
+				c[i] = 0
+				i++
+				continue
+			}
+			if i%2 == 0 {
+				perm[0], perm[i] = perm[i], perm[0]
+			} else {
+				perm[c[i]], perm[i] = perm[i], perm[c[i]]
+			}
+			if !yield(fromCopy(perm)) {
+				return
+			}
+			c[i]++
+			i = 1
+		}
+	}
+}
+
+// fromCopy returns a new slice holding a copy of the elements of a.
+func fromCopy[T any](a []T) *Slice[T] {
+	if len(a) == 0 {
+		return nil
+	}
+	result := make([]T, len(a))
+	copy(result, a)
+	return FromArray(result)
+}
+
+// Combinations returns an iterator over all k-element combinations
+// of the elements of s.
+// Each one is yielded as a new slice,
+// with its elements in the same order as in s.
+// If k is 0 there is a single, empty combination;
+// if k is negative or exceeds s.Len() there are none.
+func Combinations[T any](s *Slice[T], k int) iter.Seq[*Slice[T]] {
+	return func(yield func(*Slice[T]) bool) {
+		n := s.Len()
+		if k < 0 || k > n {
+			return
+		}
+
+		// idx holds the indices (in s) of the current combination's elements,
+		// in increasing order.
+		var (
+			idx  = make([]int, k)
+			comb = make([]T, k)
+		)
+		for i := range idx {
+			idx[i] = i
+		}
+
+		for {
+			for i, j := range idx {
+				comb[i] = s.At(j)
+			}
+			if !yield(fromCopy(comb)) {
+				return
+			}
+
+			// Advance the rightmost index that still has room to move,
+			// and reset the ones after it to follow on consecutively.
+			i := k - 1
+			for i >= 0 && idx[i] == n-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			idx[i]++
+			for j := i + 1; j < k; j++ {
+				idx[j] = idx[j-1] + 1
+			}
+		}
+	}
+}
+
+// CartesianProduct returns an iterator over every tuple
+// formed by taking one element from each of the given slices,
+// in lexicographic order:
+// the last slice's element varies fastest.
+// Each tuple is yielded as a new slice.
+// With no slices there is a single, empty tuple;
+// if any slice is empty there are none.
+func CartesianProduct[T any](slices ...*Slice[T]) iter.Seq[*Slice[T]] {
+	return func(yield func(*Slice[T]) bool) {
+		for _, s := range slices {
+			if s.Len() == 0 {
+				return
+			}
+		}
+
+		var (
+			idx   = make([]int, len(slices))
+			tuple = make([]T, len(slices))
+		)
+		for {
+			for i, j := range idx {
+				tuple[i] = slices[i].At(j)
+			}
+			if !yield(fromCopy(tuple)) {
+				return
+			}
+
+			// Increment idx like an odometer.
+			i := len(idx) - 1
+			for ; i >= 0; i-- {
+				idx[i]++
+				if idx[i] < slices[i].Len() {
+					break
+				}
+				idx[i] = 0
+			}
+			if i < 0 {
+				return
+			}
+		}
+	}
+}
+
+// Cycle returns an iterator that repeats the elements of s endlessly.
+// The caller must break out of the loop at some point.
+// If s is empty, the iterator yields nothing
+// (rather than looping forever without yielding).
+func (s *Slice[T]) Cycle() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if s.Len() == 0 {
+			return
+		}
+		for {
+			for i := 0; i < s.Len(); i++ {
+				if !yield(s.At(i)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// PowerSet returns an iterator over all 2^n subsets of the n elements of s,
+// starting with the empty set.
+// Each subset is yielded as a new slice,
+// with its elements in the same order as in s.
+// Since the number of subsets grows so quickly,
+// callers will usually want to break out of the loop early for large s.
+func PowerSet[T any](s *Slice[T]) iter.Seq[*Slice[T]] {
+	return func(yield func(*Slice[T]) bool) {
+		var (
+			n = s.Len()
+
+			// in is a binary counter, least significant digit first,
+			// telling which elements of s are in the current subset.
+			in     = make([]bool, n)
+			subset = make([]T, 0, n)
+		)
+		for {
+			subset = subset[:0]
+			for i, ok := range in {
+				if ok {
+					subset = append(subset, s.At(i))
+				}
+			}
+			if !yield(fromCopy(subset)) {
+				return
+			}
+
+			i := 0
+			for ; i < n && in[i]; i++ {
+				in[i] = false
+			}
+			if i == n {
+				return
+			}
+			in[i] = true
+		}
+	}
+}
+
+// ValuesBackward returns an iterator over the elements of s
+// from last to first,
+// like slices.Backward but without the indices.
+// It does not make a reversed copy of s.
+func (s *Slice[T]) ValuesBackward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := s.Len() - 1; i >= 0; i-- {
+			if !yield(s.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// DedupSeq returns an iterator
+// that yields the values of seq,
+// skipping any value it has already yielded.
+// It remembers every distinct value it sees,
+// so it needs memory proportional to the number of them.
+func DedupSeq[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := make(map[T]struct{})
+		for v := range seq {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns a new slice
+// containing the values produced by seq, in order.
+func Collect[T any](seq iter.Seq[T]) *Slice[T] {
+	var result *Slice[T]
+	for v := range seq {
+		result = result.Append(v)
+	}
+	return result
+}
+
+// Collect2 returns a new slice
+// containing the pairs of values produced by seq, in order.
+func Collect2[K, V any](seq iter.Seq2[K, V]) *Slice[Pair[K, V]] {
+	var result *Slice[Pair[K, V]]
+	for k, v := range seq {
+		result = result.Append(Pair[K, V]{First: k, Second: v})
+	}
+	return result
+}
+
+// CollectReverse is like Collect,
+// but the values are in reverse order:
+// the last value produced is first.
+// It appends the values as they arrive
+// and then reverses the result in place.
+func CollectReverse[T any](seq iter.Seq[T]) *Slice[T] {
+	result := Collect(seq)
+	if result != nil {
+		reverse(result.storage[result.offset : result.offset+result.length])
+	}
+	return result
+}
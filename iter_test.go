@@ -0,0 +1,313 @@
+package slice
+
+import (
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestPairwise(t *testing.T) {
+	var got []Pair[int, int]
+	for a, b := range Pairwise(From(1, 2, 3, 4)) {
+		got = append(got, Pair[int, int]{First: a, Second: b})
+	}
+	want := []Pair[int, int]{{1, 2}, {2, 3}, {3, 4}}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	for range Pairwise(From(1)) {
+		t.Error("a one-element slice should yield no pairs")
+	}
+	for range Pairwise[int](nil) {
+		t.Error("a nil slice should yield no pairs")
+	}
+
+	n := 0
+	for range Pairwise(From(1, 2, 3, 4)) {
+		n++
+		if n == 2 {
+			break
+		}
+	}
+	if n != 2 {
+		t.Errorf("got %d, want 2", n)
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	for n, want := range []int{1, 1, 2, 6, 24, 120} {
+		s := Iterate(0, n, func(x int) int { return x + 1 })
+		count := 0
+		for range Permutations(s) {
+			count++
+		}
+		if count != want {
+			t.Errorf("n=%d: got %d permutations, want %d", n, count, want)
+		}
+	}
+
+	var got []string
+	for p := range Permutations(From("a", "b", "c")) {
+		got = append(got, strings.Join(elems(p), ""))
+	}
+	slices.Sort(got)
+	want := []string{"abc", "acb", "bac", "bca", "cab", "cba"}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	count := 0
+	for range Permutations(From(1, 2, 3, 4)) {
+		count++
+		if count == 5 {
+			break
+		}
+	}
+	if count != 5 {
+		t.Errorf("got %d, want 5", count)
+	}
+}
+
+func TestPermutationsFresh(t *testing.T) {
+	var perms []*Slice[int]
+	for p := range Permutations(From(1, 2, 3)) {
+		perms = append(perms, p)
+	}
+	for i := 0; i < len(perms); i++ {
+		for j := i + 1; j < len(perms); j++ {
+			if slices.Equal(elems(perms[i]), elems(perms[j])) {
+				t.Errorf("permutations %d and %d are both %v", i, j, elems(perms[i]))
+			}
+		}
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	s := From(1, 2, 3, 4, 5)
+	for k, want := range []int{1, 5, 10, 10, 5, 1, 0} {
+		count := 0
+		for range Combinations(s, k) {
+			count++
+		}
+		if count != want {
+			t.Errorf("k=%d: got %d combinations, want %d", k, count, want)
+		}
+	}
+
+	var got []string
+	for c := range Combinations(From("a", "b", "c", "d"), 2) {
+		got = append(got, strings.Join(elems(c), ""))
+	}
+	want := []string{"ab", "ac", "ad", "bc", "bd", "cd"}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	count := 0
+	for range Combinations(s, 2) {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	if count != 3 {
+		t.Errorf("got %d, want 3", count)
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	var got []string
+	for tuple := range CartesianProduct(From("a", "b"), From("x", "y", "z")) {
+		got = append(got, strings.Join(elems(tuple), ""))
+	}
+	want := []string{"ax", "ay", "az", "bx", "by", "bz"}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	got = nil
+	for tuple := range CartesianProduct(From("a", "b"), From("x"), From("1", "2")) {
+		got = append(got, strings.Join(elems(tuple), ""))
+	}
+	want = []string{"ax1", "ax2", "bx1", "bx2"}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	count := 0
+	for tuple := range CartesianProduct[string]() {
+		if l := tuple.Len(); l != 0 {
+			t.Errorf("got %d, want 0", l)
+		}
+		count++
+	}
+	if count != 1 {
+		t.Errorf("got %d, want 1", count)
+	}
+
+	for range CartesianProduct(From("a", "b"), nil) {
+		t.Error("an empty input slice should yield no tuples")
+	}
+}
+
+func TestCycle(t *testing.T) {
+	var got []string
+	for v := range From("a", "b", "c").Cycle() {
+		got = append(got, v)
+		if len(got) == 8 {
+			break
+		}
+	}
+	if want := []string{"a", "b", "c", "a", "b", "c", "a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	for range (*Slice[string])(nil).Cycle() {
+		t.Fatal("cycling over an empty slice should yield nothing")
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	for n := 0; n <= 6; n++ {
+		var (
+			s     = Iterate(0, n, func(x int) int { return x + 1 })
+			count = 0
+			first = true
+		)
+		for subset := range PowerSet(s) {
+			if first && subset.Len() != 0 {
+				t.Errorf("n=%d: first subset is %v, want the empty set", n, elems(subset))
+			}
+			first = false
+			count++
+		}
+		if want := 1 << n; count != want {
+			t.Errorf("n=%d: got %d subsets, want %d", n, count, want)
+		}
+	}
+
+	var got []string
+	for subset := range PowerSet(From("a", "b", "c")) {
+		got = append(got, strings.Join(elems(subset), ""))
+	}
+	slices.Sort(got)
+	if want := []string{"", "a", "ab", "abc", "ac", "b", "bc", "c"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	count := 0
+	for range PowerSet(Iterate(0, 100, func(x int) int { return x + 1 })) {
+		count++
+		if count == 10 {
+			break
+		}
+	}
+	if count != 10 {
+		t.Errorf("got %d, want 10", count)
+	}
+}
+
+func TestValuesBackward(t *testing.T) {
+	s := From("a", "b", "c", "d", "e").Subslice(1, 4)
+
+	var got []string
+	for v := range s.ValuesBackward() {
+		got = append(got, v)
+	}
+	if want := []string{"d", "c", "b"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	got = nil
+	for v := range s.ValuesBackward() {
+		got = append(got, v)
+		if len(got) == 2 {
+			break
+		}
+	}
+	if want := []string{"d", "c"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	for range (*Slice[string])(nil).ValuesBackward() {
+		t.Error("a nil slice should yield nothing")
+	}
+}
+
+func TestDedupSeq(t *testing.T) {
+	seq := slices.Values([]string{"b", "a", "b", "c", "a", "d", "c"})
+
+	var got []string
+	for v := range DedupSeq(seq) {
+		got = append(got, v)
+	}
+	if want := []string{"b", "a", "c", "d"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	got = nil
+	for v := range DedupSeq(seq) {
+		got = append(got, v)
+		if len(got) == 2 {
+			break
+		}
+	}
+	if want := []string{"b", "a"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestCollectReverse(t *testing.T) {
+	got := CollectReverse(slices.Values([]int{1, 2, 3, 4, 5}))
+	if l := got.Len(); l != 5 {
+		t.Errorf("got length %d, want 5", l)
+	}
+	if want := []int{5, 4, 3, 2, 1}; !slices.Equal(elems(got), want) {
+		t.Errorf("got %v, want %v", elems(got), want)
+	}
+
+	if l := CollectReverse(slices.Values([]int(nil))).Len(); l != 0 {
+		t.Errorf("got length %d, want 0", l)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	count := func(n int) iter.Seq[int] {
+		return func(yield func(int) bool) {
+			for i := 0; i < n; i++ {
+				if !yield(i * 10) {
+					return
+				}
+			}
+		}
+	}
+
+	got := Collect(count(4))
+	if l := got.Len(); l != 4 {
+		t.Errorf("got length %d, want 4", l)
+	}
+	if want := []int{0, 10, 20, 30}; !slices.Equal(elems(got), want) {
+		t.Errorf("got %v, want %v", elems(got), want)
+	}
+
+	if l := Collect(count(0)).Len(); l != 0 {
+		t.Errorf("got length %d, want 0", l)
+	}
+}
+
+func TestCollect2(t *testing.T) {
+	got := Collect2(slices.All([]string{"a", "b", "c"}))
+	if l := got.Len(); l != 3 {
+		t.Errorf("got length %d, want 3", l)
+	}
+	if want := []Pair[int, string]{{0, "a"}, {1, "b"}, {2, "c"}}; !slices.Equal(elems(got), want) {
+		t.Errorf("got %v, want %v", elems(got), want)
+	}
+
+	pairs := Collect2(Pairwise(From("x", "y", "z")))
+	if want := []Pair[string, string]{{"x", "y"}, {"y", "z"}}; !slices.Equal(elems(pairs), want) {
+		t.Errorf("got %v, want %v", elems(pairs), want)
+	}
+}
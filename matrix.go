@@ -0,0 +1,91 @@
+package slice
+
+import "fmt"
+
+// Matrix is a two-dimensional grid of elements
+// stored in a single Slice, one row after another
+// ("row-major order").
+// Element (r, c) is at index r*cols+c of the underlying slice.
+type Matrix[T any] struct {
+	items      *Slice[T]
+	rows, cols int
+}
+
+// NewMatrix creates a Matrix with the given dimensions,
+// with every element set to the zero value.
+func NewMatrix[T any](rows, cols int) *Matrix[T] {
+	if rows < 0 || cols < 0 {
+		panic("dimensions must not be negative")
+	}
+	return &Matrix[T]{
+		items: Make[T](rows*cols, rows*cols),
+		rows:  rows,
+		cols:  cols,
+	}
+}
+
+// Rows is the number of rows in m.
+func (m *Matrix[T]) Rows() int {
+	return m.rows
+}
+
+// Cols is the number of columns in m.
+func (m *Matrix[T]) Cols() int {
+	return m.cols
+}
+
+// At returns the element at row r, column c.
+func (m *Matrix[T]) At(r, c int) T {
+	return m.items.At(m.index(r, c))
+}
+
+// Set sets the element at row r, column c to v.
+func (m *Matrix[T]) Set(r, c int, v T) {
+	*m.items.AtPtr(m.index(r, c)) = v
+}
+
+// Row returns row r of m.
+// Since rows are contiguous in the underlying storage,
+// this is a Subslice sharing that storage:
+// changes to it are changes to m.
+// It is clipped (see [Slice.Clip]),
+// so appending to it reallocates
+// instead of overwriting the next row.
+func (m *Matrix[T]) Row(r int) *Slice[T] {
+	m.checkRow(r)
+	return m.items.Subslice(r*m.cols, (r+1)*m.cols).Clip()
+}
+
+// Col returns column c of m.
+// Columns are not contiguous in the underlying storage,
+// so this is a copy:
+// changes to it do not affect m.
+func (m *Matrix[T]) Col(c int) *Slice[T] {
+	m.checkCol(c)
+	if m.rows == 0 {
+		return nil
+	}
+	result := Make[T](m.rows, m.rows)
+	for r := 0; r < m.rows; r++ {
+		result.storage[r] = m.At(r, c)
+	}
+	return result
+}
+
+func (m *Matrix[T]) index(r, c int) int {
+	m.checkRow(r)
+	m.checkCol(c)
+	return r*m.cols + c
+}
+
+func (m *Matrix[T]) checkRow(r int) {
+	if r < 0 || r >= m.rows {
+		panic(fmt.Sprintf("row index out of range: %d (rows: %d)", r, m.rows))
+	}
+}
+
+func (m *Matrix[T]) checkCol(c int) {
+	if c < 0 || c >= m.cols {
+		panic(fmt.Sprintf("column index out of range: %d (cols: %d)", c, m.cols))
+	}
+}
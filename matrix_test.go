@@ -0,0 +1,70 @@
+package slice
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMatrix(t *testing.T) {
+	m := NewMatrix[int](2, 3)
+	for r := 0; r < m.Rows(); r++ {
+		for c := 0; c < m.Cols(); c++ {
+			m.Set(r, c, 10*r+c)
+		}
+	}
+
+	if want := []int{0, 1, 2, 10, 11, 12}; !slices.Equal(elems(m.items), want) {
+		t.Errorf("got storage %v, want %v", elems(m.items), want)
+	}
+	if v := m.At(1, 2); v != 12 {
+		t.Errorf("got %d, want 12", v)
+	}
+
+	row := m.Row(1)
+	if got := elems(row); !slices.Equal(got, []int{10, 11, 12}) {
+		t.Errorf("got %v, want [10 11 12]", got)
+	}
+	*row.AtPtr(0) = 99
+	if v := m.At(1, 0); v != 99 {
+		t.Errorf("Row should alias m; got %d, want 99", v)
+	}
+
+	row = m.Row(0).Append(42)
+	if v := m.At(1, 0); v != 99 {
+		t.Errorf("appending to a row should not overwrite the next one; got %d, want 99", v)
+	}
+	if row.SharesStorageWith(m.items) {
+		t.Error("appending to a row should reallocate")
+	}
+
+	col := m.Col(1)
+	if got := elems(col); !slices.Equal(got, []int{1, 11}) {
+		t.Errorf("got %v, want [1 11]", got)
+	}
+	*col.AtPtr(0) = 99
+	if v := m.At(0, 1); v != 1 {
+		t.Errorf("Col should not alias m; got %d, want 1", v)
+	}
+}
+
+func TestMatrixPanics(t *testing.T) {
+	m := NewMatrix[int](2, 3)
+	cases := map[string]func(){
+		"At row":  func() { m.At(2, 0) },
+		"At col":  func() { m.At(0, 3) },
+		"Set neg": func() { m.Set(-1, 0, 1) },
+		"Row":     func() { m.Row(2) },
+		"Col":     func() { m.Col(3) },
+		"New":     func() { NewMatrix[int](-1, 2) },
+	}
+	for name, f := range cases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected a panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
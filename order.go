@@ -0,0 +1,167 @@
+package slice
+
+// MoveElement removes the element at index from
+// and reinserts it at index to,
+// shifting the elements in between over by one.
+// It works in place, within s's portion of the underlying storage.
+func (s *Slice[T]) MoveElement(from, to int) {
+	s.checkIndex(from)
+	s.checkIndex(to)
+
+	var (
+		window = s.storage[s.offset : s.offset+s.length]
+		item   = window[from]
+	)
+	if from < to {
+		copy(window[from:to], window[from+1:to+1])
+	} else {
+		copy(window[to+1:from+1], window[to:from])
+	}
+	window[to] = item
+}
+
+// Swap exchanges the elements at indices i and j.
+// It is like s[i], s[j] = s[j], s[i].
+func (s *Slice[T]) Swap(i, j int) {
+	s.checkIndex(i)
+	s.checkIndex(j)
+
+	i, j = s.offset+i, s.offset+j
+	s.storage[i], s.storage[j] = s.storage[j], s.storage[i]
+}
+
+// Rotate rotates the elements of s in place, k positions to the left,
+// so the element at index k moves to index 0.
+// A negative k rotates to the right.
+// Rotating by a multiple of s.Len() leaves s unchanged.
+//
+// This uses the three-reversal trick,
+// which needs no extra storage.
+func (s *Slice[T]) Rotate(k int) {
+	n := s.Len()
+	if n == 0 {
+		return
+	}
+	k %= n
+	if k < 0 {
+		k += n
+	}
+	if k == 0 {
+		return
+	}
+	rotate(s.storage[s.offset:s.offset+n], k)
+}
+
+// RotateLeft is like Rotate(k) but panics if k is negative.
+func (s *Slice[T]) RotateLeft(k int) {
+	if k < 0 {
+		panic("rotation must not be negative")
+	}
+	s.Rotate(k)
+}
+
+// RotateRight is like Rotate(-k) but panics if k is negative.
+func (s *Slice[T]) RotateRight(k int) {
+	if k < 0 {
+		panic("rotation must not be negative")
+	}
+	if n := s.Len(); n > 0 {
+		s.Rotate(n - k%n)
+	}
+}
+
+// StablePartition rearranges the elements of s in place
+// so that all those satisfying pred come first,
+// followed by all those that don't.
+// It returns the number of elements satisfying pred,
+// which is the index of the boundary between the two groups.
+//
+// Within each group, the elements keep their original relative order.
+// Doing that without allocating takes O(n log n) time:
+// each half is partitioned recursively,
+// and then the two middle groups trade places with a rotation.
+func (s *Slice[T]) StablePartition(pred func(T) bool) int {
+	if s == nil {
+		return 0
+	}
+	return stablePartition(s.storage[s.offset:s.offset+s.length], pred)
+}
+
+// PartitionInPlace is like StablePartition,
+// but does not preserve the relative order of the elements in either group.
+// In exchange it is faster: a single pass, with no recursion.
+// It works inward from both ends,
+// swapping each non-matching element from the front
+// with a matching element from the back.
+func (s *Slice[T]) PartitionInPlace(pred func(T) bool) int {
+	if s == nil {
+		return 0
+	}
+
+	var (
+		a    = s.storage[s.offset : s.offset+s.length]
+		i, j = 0, len(a) - 1
+	)
+	for {
+		for i <= j && pred(a[i]) {
+			i++
+		}
+		for i <= j && !pred(a[j]) {
+			j--
+		}
+		if i >= j {
+			return i
+		}
+		a[i], a[j] = a[j], a[i]
+		i++
+		j--
+	}
+}
+
+func stablePartition[T any](a []T, pred func(T) bool) int {
+	switch len(a) {
+	case 0:
+		return 0
+	case 1:
+		if pred(a[0]) {
+			return 1
+		}
+		return 0
+	}
+
+	var (
+		mid   = len(a) / 2
+		left  = stablePartition(a[:mid], pred)
+		right = stablePartition(a[mid:], pred)
+	)
+
+	// Now a[:left] satisfies pred, a[left:mid] doesn't,
+	// a[mid:mid+right] does, and a[mid+right:] doesn't.
+	rotate(a[left:mid+right], mid-left)
+	return left + right
+}
+
+// rotate rotates a left by k positions,
+// for 0 <= k <= len(a),
+// by reversing each part and then the whole.
+func rotate[T any](a []T, k int) {
+	reverse(a[:k])
+	reverse(a[k:])
+	reverse(a)
+}
+
+func reverse[T any](a []T) {
+	for i, j := 0, len(a)-1; i < j; i, j = i+1, j-1 {
+		a[i], a[j] = a[j], a[i]
+	}
+}
+
+// Rotated is like Rotate,
+// but instead of rotating s in place
+// it returns a rotated copy, in new storage,
+// leaving s (and any slices sharing its storage) untouched.
+func (s *Slice[T]) Rotated(k int) *Slice[T] {
+	result := s.CloneInto(nil)
+	result.Rotate(k)
+	return result
+}
@@ -0,0 +1,211 @@
+package slice
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMoveElement(t *testing.T) {
+	cases := []struct {
+		from, to int
+		want     []string
+	}{
+		{from: 1, to: 3, want: []string{"a", "c", "d", "b", "e"}},
+		{from: 4, to: 0, want: []string{"e", "a", "b", "c", "d"}},
+		{from: 2, to: 2, want: []string{"a", "b", "c", "d", "e"}},
+	}
+	for _, c := range cases {
+		s := From("a", "b", "c", "d", "e")
+		s.MoveElement(c.from, c.to)
+		if got := elems(s); !slices.Equal(got, c.want) {
+			t.Errorf("MoveElement(%d, %d): got %v, want %v", c.from, c.to, got, c.want)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	From("a", "b").MoveElement(0, 2)
+}
+
+func TestSwap(t *testing.T) {
+	var (
+		parent = From("a", "b", "c", "d", "e")
+		s      = parent.Subslice(1, 4)
+	)
+	s.Swap(0, 2)
+	if got := elems(s); !slices.Equal(got, []string{"d", "c", "b"}) {
+		t.Errorf("got %v, want [d c b]", got)
+	}
+	if got := elems(parent); !slices.Equal(got, []string{"a", "d", "c", "b", "e"}) {
+		t.Errorf("got %v, want [a d c b e]", got)
+	}
+
+	s.Swap(1, 1)
+	if got := elems(s); !slices.Equal(got, []string{"d", "c", "b"}) {
+		t.Errorf("got %v, want [d c b]", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	s.Swap(0, 3)
+}
+
+func TestRotate(t *testing.T) {
+	cases := []struct {
+		k    int
+		want []int
+	}{
+		{k: 0, want: []int{1, 2, 3, 4, 5}},
+		{k: 2, want: []int{3, 4, 5, 1, 2}},
+		{k: -2, want: []int{4, 5, 1, 2, 3}},
+		{k: 5, want: []int{1, 2, 3, 4, 5}},
+		{k: 7, want: []int{3, 4, 5, 1, 2}},
+	}
+	for _, c := range cases {
+		parent := From(0, 1, 2, 3, 4, 5, 6)
+		s := parent.Subslice(1, 6)
+		s.Rotate(c.k)
+		if got := elems(s); !slices.Equal(got, c.want) {
+			t.Errorf("Rotate(%d): got %v, want %v", c.k, got, c.want)
+		}
+		if v := parent.At(0); v != 0 {
+			t.Errorf("Rotate(%d) changed the element before the window to %d", c.k, v)
+		}
+		if v := parent.At(6); v != 6 {
+			t.Errorf("Rotate(%d) changed the element after the window to %d", c.k, v)
+		}
+	}
+}
+
+func TestRotateLeftRight(t *testing.T) {
+	cases := []struct {
+		k                   int
+		wantLeft, wantRight []int
+	}{
+		{k: 0, wantLeft: []int{1, 2, 3, 4}, wantRight: []int{1, 2, 3, 4}},
+		{k: 1, wantLeft: []int{2, 3, 4, 1}, wantRight: []int{4, 1, 2, 3}},
+		{k: 4, wantLeft: []int{1, 2, 3, 4}, wantRight: []int{1, 2, 3, 4}},
+		{k: 6, wantLeft: []int{3, 4, 1, 2}, wantRight: []int{3, 4, 1, 2}},
+		{k: 7, wantLeft: []int{4, 1, 2, 3}, wantRight: []int{2, 3, 4, 1}},
+	}
+	for _, c := range cases {
+		s := From(1, 2, 3, 4)
+		s.RotateLeft(c.k)
+		if got := elems(s); !slices.Equal(got, c.wantLeft) {
+			t.Errorf("RotateLeft(%d): got %v, want %v", c.k, got, c.wantLeft)
+		}
+
+		s = From(1, 2, 3, 4)
+		s.RotateRight(c.k)
+		if got := elems(s); !slices.Equal(got, c.wantRight) {
+			t.Errorf("RotateRight(%d): got %v, want %v", c.k, got, c.wantRight)
+		}
+	}
+
+	for _, f := range []func(int){From(1, 2).RotateLeft, From(1, 2).RotateRight} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected a panic")
+				}
+			}()
+			f(-1)
+		}()
+	}
+}
+
+func TestStablePartition(t *testing.T) {
+	var (
+		parent = From(100, 1, 2, 3, 4, 5, 6, 7, 8, 9, 200)
+		s      = parent.Subslice(1, 10)
+		n      = s.StablePartition(func(x int) bool { return x%3 == 0 })
+	)
+	if n != 3 {
+		t.Errorf("got %d, want 3", n)
+	}
+	if got := elems(s); !slices.Equal(got, []int{3, 6, 9, 1, 2, 4, 5, 7, 8}) {
+		t.Errorf("got %v, want [3 6 9 1 2 4 5 7 8]", got)
+	}
+	if v := parent.At(0); v != 100 {
+		t.Errorf("got %d, want 100", v)
+	}
+	if v := parent.At(10); v != 200 {
+		t.Errorf("got %d, want 200", v)
+	}
+
+	s = From(1, 3, 5)
+	if n := s.StablePartition(func(x int) bool { return x%2 == 0 }); n != 0 {
+		t.Errorf("got %d, want 0", n)
+	}
+	if got := elems(s); !slices.Equal(got, []int{1, 3, 5}) {
+		t.Errorf("got %v, want [1 3 5]", got)
+	}
+}
+
+func TestPartitionInPlace(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+
+	cases := [][]int{
+		nil,
+		{1},
+		{2},
+		{1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{2, 4, 6, 1, 3},
+		{1, 3, 5, 2, 4},
+		{1, 3, 5},
+		{2, 4, 6},
+	}
+	for _, items := range cases {
+		var (
+			orig = slices.Clone(items)
+			s    = FromArray(items)
+			n    = s.PartitionInPlace(isEven)
+		)
+		for i := 0; i < s.Len(); i++ {
+			if got := isEven(s.At(i)); got != (i < n) {
+				t.Errorf("%v: boundary %d, but element %d is %d", orig, n, i, s.At(i))
+			}
+		}
+		if !slices.Equal(slices.Sorted(slices.Values(elems(s))), slices.Sorted(slices.Values(orig))) {
+			t.Errorf("%v: elements changed to %v", orig, elems(s))
+		}
+	}
+}
+
+func TestRotated(t *testing.T) {
+	s := From(1, 2, 3, 4, 5)
+
+	cases := []struct {
+		k    int
+		want []int
+	}{
+		{k: 0, want: []int{1, 2, 3, 4, 5}},
+		{k: 1, want: []int{2, 3, 4, 5, 1}},
+		{k: -1, want: []int{5, 1, 2, 3, 4}},
+		{k: 5, want: []int{1, 2, 3, 4, 5}},
+		{k: 12, want: []int{3, 4, 5, 1, 2}},
+		{k: -12, want: []int{4, 5, 1, 2, 3}},
+	}
+	for _, c := range cases {
+		got := s.Rotated(c.k)
+		if !slices.Equal(elems(got), c.want) {
+			t.Errorf("Rotated(%d): got %v, want %v", c.k, elems(got), c.want)
+		}
+		if got.SharesStorageWith(s) {
+			t.Errorf("Rotated(%d) shares storage with s", c.k)
+		}
+	}
+	if !slices.Equal(elems(s), []int{1, 2, 3, 4, 5}) {
+		t.Errorf("s changed to %v", elems(s))
+	}
+
+	if got := (*Slice[int])(nil).Rotated(3); got.Len() != 0 {
+		t.Errorf("got %v, want []", elems(got))
+	}
+}
@@ -0,0 +1,87 @@
+package slice
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// Pair holds two values of possibly different types.
+type Pair[T, U any] struct {
+	First  T
+	Second U
+}
+
+// Enumerate returns a new slice of (index, value) pairs,
+// one for each element of s.
+// The indices are positions within s,
+// not within its underlying storage.
+func Enumerate[T any](s *Slice[T]) *Slice[Pair[int, T]] {
+	n := s.Len()
+	if n == 0 {
+		return nil
+	}
+	result := Make[Pair[int, T]](n, n)
+	for i := 0; i < n; i++ {
+		result.storage[i] = Pair[int, T]{First: i, Second: s.At(i)}
+	}
+	return result
+}
+
+// ZipWith returns a new slice whose elements are f(a[i], b[i]).
+// Its length is the shorter of a's and b's;
+// extra elements in the longer one are ignored.
+func ZipWith[T, U, R any](a *Slice[T], b *Slice[U], f func(T, U) R) *Slice[R] {
+	n := min(a.Len(), b.Len())
+	if n == 0 {
+		return nil
+	}
+	result := Make[R](n, n)
+	for i := 0; i < n; i++ {
+		result.storage[i] = f(a.At(i), b.At(i))
+	}
+	return result
+}
+
+// SameLength tells whether a and b have the same length.
+// A nil slice has the same length as an empty one.
+func SameLength[T, U any](a *Slice[T], b *Slice[U]) bool {
+	return a.Len() == b.Len()
+}
+
+// MustSameLength panics if a and b have different lengths.
+// It is for functions that operate element-wise on two slices
+// and require them to match up.
+func MustSameLength[T, U any](a *Slice[T], b *Slice[U]) {
+	if !SameLength(a, b) {
+		panic(fmt.Sprintf("length mismatch: %d != %d", a.Len(), b.Len()))
+	}
+}
+
+// PairsToMap builds a map from a slice of key-value pairs.
+// If the same key appears more than once,
+// the last pair with that key wins.
+func PairsToMap[K comparable, V any](s *Slice[Pair[K, V]]) map[K]V {
+	result := make(map[K]V, s.Len())
+	for i := 0; i < s.Len(); i++ {
+		p := s.At(i)
+		result[p.First] = p.Second
+	}
+	return result
+}
+
+// MapToPairs is the inverse of PairsToMap.
+// It returns the entries of m as a slice of key-value pairs,
+// sorted by key
+// (so the result doesn't depend on Go's random map iteration order).
+func MapToPairs[K cmp.Ordered, V any](m map[K]V) *Slice[Pair[K, V]] {
+	if len(m) == 0 {
+		return nil
+	}
+	result := Make[Pair[K, V]](0, len(m))
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		result = result.Append(Pair[K, V]{First: k, Second: m[k]})
+	}
+	return result
+}
@@ -0,0 +1,116 @@
+package slice
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestEnumerate(t *testing.T) {
+	var (
+		s   = From("a", "b", "c", "d", "e").Subslice(2, 5)
+		got = Enumerate(s)
+	)
+	if l := got.Len(); l != 3 {
+		t.Fatalf("got %d, want 3", l)
+	}
+	for i, want := range []string{"c", "d", "e"} {
+		p := got.At(i)
+		if p.First != i {
+			t.Errorf("got index %d, want %d", p.First, i)
+		}
+		if p.Second != want {
+			t.Errorf("got %q, want %q", p.Second, want)
+		}
+	}
+
+	if l := Enumerate[string](nil).Len(); l != 0 {
+		t.Errorf("got %d, want 0", l)
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	add := func(x, y int) int { return x + y }
+
+	got := ZipWith(From(1, 2, 3), From(10, 20, 30), add)
+	if !slices.Equal(elems(got), []int{11, 22, 33}) {
+		t.Errorf("got %v, want [11 22 33]", elems(got))
+	}
+
+	got = ZipWith(From(1, 2, 3, 4), From(10, 20), add)
+	if !slices.Equal(elems(got), []int{11, 22}) {
+		t.Errorf("got %v, want [11 22]", elems(got))
+	}
+
+	got = ZipWith(nil, From(10, 20), add)
+	if l := got.Len(); l != 0 {
+		t.Errorf("got %d, want 0", l)
+	}
+}
+
+func TestSameLength(t *testing.T) {
+	cases := []struct {
+		a    *Slice[int]
+		b    *Slice[string]
+		want bool
+	}{
+		{a: From(1, 2), b: From("a", "b"), want: true},
+		{a: From(1, 2), b: From("a"), want: false},
+		{a: nil, b: Make[string](0, 3), want: true},
+		{a: nil, b: nil, want: true},
+		{a: nil, b: From("a"), want: false},
+	}
+	for i, c := range cases {
+		if got := SameLength(c.a, c.b); got != c.want {
+			t.Errorf("case %d: got %v, want %v", i, got, c.want)
+		}
+	}
+}
+
+func TestMustSameLength(t *testing.T) {
+	MustSameLength(From(1, 2), From("a", "b"))
+	MustSameLength[int, int](nil, Make[int](0, 1))
+
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected a panic")
+		}
+		if msg, ok := r.(string); !ok || msg != "length mismatch: 2 != 1" {
+			t.Errorf("got panic %v, want \"length mismatch: 2 != 1\"", r)
+		}
+	}()
+	MustSameLength(From(1, 2), From("a"))
+}
+
+func TestPairsToMap(t *testing.T) {
+	s := From(
+		Pair[string, int]{"a", 1},
+		Pair[string, int]{"b", 2},
+		Pair[string, int]{"a", 3},
+	)
+	got := PairsToMap(s)
+	if want := map[string]int{"a": 3, "b": 2}; !maps.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got := PairsToMap[string, int](nil); got == nil || len(got) != 0 {
+		t.Errorf("got %v, want an empty map", got)
+	}
+}
+
+func TestMapToPairs(t *testing.T) {
+	m := map[string]int{"c": 3, "a": 1, "b": 2}
+	pairs := MapToPairs(m)
+	want := []Pair[string, int]{{"a", 1}, {"b", 2}, {"c", 3}}
+	if !slices.Equal(elems(pairs), want) {
+		t.Errorf("got %v, want %v", elems(pairs), want)
+	}
+	if got := PairsToMap(pairs); !maps.Equal(got, m) {
+		t.Errorf("round trip: got %v, want %v", got, m)
+	}
+
+	if l := MapToPairs[string, int](nil).Len(); l != 0 {
+		t.Errorf("got %d, want 0", l)
+	}
+}
@@ -0,0 +1,68 @@
+package slice
+
+// Queue is a first-in, first-out collection built on a Slice.
+// The zero value is an empty queue ready to use.
+//
+// Enqueue appends to the underlying slice
+// and Dequeue subslices it,
+// advancing its offset into the storage.
+// Left alone, that would leave a growing run of dead elements
+// at the front of the storage.
+// So whenever the dead prefix exceeds half the storage,
+// Dequeue shifts the live elements back to the front,
+// letting later Enqueues reuse the space.
+type Queue[T any] struct {
+	items *Slice[T]
+}
+
+// Enqueue adds v to the back of the queue.
+func (q *Queue[T]) Enqueue(v T) {
+	q.items = q.items.Append(v)
+}
+
+// Dequeue removes and returns the element at the front of the queue.
+// It returns false if the queue is empty.
+func (q *Queue[T]) Dequeue() (T, bool) {
+	v, ok := q.Peek()
+	if !ok {
+		return v, false
+	}
+
+	var zero T
+	*q.items.AtPtr(0) = zero
+	q.items = q.items.Subslice(1, q.items.Len())
+	q.compact()
+
+	return v, true
+}
+
+// Peek returns the element at the front of the queue without removing it.
+// It returns false if the queue is empty.
+func (q *Queue[T]) Peek() (T, bool) {
+	return q.items.TryAt(0)
+}
+
+// Len is the number of elements in the queue.
+func (q *Queue[T]) Len() int {
+	return q.items.Len()
+}
+
+func (q *Queue[T]) compact() {
+	if q.items.offset <= len(q.items.storage)/2 {
+		return
+	}
+
+	var (
+		oldEnd = q.items.offset + q.items.length
+		front  = &Slice[T]{storage: q.items.storage, length: q.items.length}
+	)
+	q.items.Copy(front)
+
+	// Zero the elements that are no longer live.
+	var zero T
+	for i := front.length; i < oldEnd; i++ {
+		front.storage[i] = zero
+	}
+
+	q.items = front
+}
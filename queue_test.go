@@ -0,0 +1,106 @@
+package slice
+
+import "testing"
+
+func TestQueue(t *testing.T) {
+	var q Queue[string]
+
+	if _, ok := q.Dequeue(); ok {
+		t.Error("dequeueing from an empty queue should fail")
+	}
+
+	q.Enqueue("a")
+	q.Enqueue("b")
+	q.Enqueue("c")
+	if l := q.Len(); l != 3 {
+		t.Errorf("got %d, want 3", l)
+	}
+	if v, ok := q.Peek(); !ok || v != "a" {
+		t.Errorf(`got %q, %v; want "a", true`, v, ok)
+	}
+
+	for _, want := range []string{"a", "b", "c"} {
+		if v, ok := q.Dequeue(); !ok || v != want {
+			t.Errorf("got %q, %v; want %q, true", v, ok, want)
+		}
+	}
+	if _, ok := q.Dequeue(); ok {
+		t.Error("dequeueing from an empty queue should fail")
+	}
+}
+
+func TestQueueCompaction(t *testing.T) {
+	var q Queue[int]
+	for i := 0; i < 10; i++ {
+		q.Enqueue(i)
+	}
+
+	next := 0
+	for i := 10; i < 10000; i++ {
+		q.Enqueue(i)
+		v, ok := q.Dequeue()
+		if !ok || v != next {
+			t.Fatalf("got %d, %v; want %d, true", v, ok, next)
+		}
+		next++
+
+		if q.items.offset > len(q.items.storage)/2 {
+			t.Fatalf("offset %d exceeds half of storage size %d", q.items.offset, len(q.items.storage))
+		}
+		if n := len(q.items.storage); n > 64 {
+			t.Fatalf("storage grew to %d", n)
+		}
+	}
+
+	for q.Len() > 0 {
+		v, _ := q.Dequeue()
+		if v != next {
+			t.Fatalf("got %d, want %d", v, next)
+		}
+		next++
+	}
+	if next != 10000 {
+		t.Errorf("got %d elements, want 10000", next)
+	}
+}
+
+func TestQueueCompact(t *testing.T) {
+	var (
+		q       = Queue[int]{items: Make[int](0, 16)}
+		storage = q.items.storage
+	)
+	for i := 1; i <= 16; i++ {
+		q.Enqueue(i)
+	}
+	if !q.items.SharesStorageWith(&Slice[int]{storage: storage}) {
+		t.Fatal("Enqueue within capacity should not reallocate")
+	}
+
+	// The ninth Dequeue pushes the offset past half the storage.
+	for i := 1; i <= 9; i++ {
+		if v, ok := q.Dequeue(); !ok || v != i {
+			t.Fatalf("got %d, %v; want %d, true", v, ok, i)
+		}
+	}
+
+	if off := q.items.offset; off != 0 {
+		t.Errorf("got offset %d, want 0", off)
+	}
+	if &q.items.storage[0] != &storage[0] {
+		t.Error("compaction should reuse the storage, not reallocate")
+	}
+	if l := q.Len(); l != 7 {
+		t.Errorf("got length %d, want 7", l)
+	}
+	for i := q.Len(); i < len(storage); i++ {
+		if storage[i] != 0 {
+			t.Errorf("freed storage slot %d is %d, want 0", i, storage[i])
+		}
+	}
+
+	for i := 10; i <= 16; i++ {
+		if v, ok := q.Dequeue(); !ok || v != i {
+			t.Errorf("got %d, %v; want %d, true", v, ok, i)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package slice
+
+import (
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"slices"
+)
+
+// SampleN returns n distinct elements of s
+// (distinct by position, not necessarily by value),
+// chosen uniformly at random, in a new slice.
+// If n exceeds s.Len(), all the elements are returned, in random order.
+//
+// Random numbers come from r.
+// If r is nil, the global source in math/rand/v2 is used.
+func SampleN[T any](s *Slice[T], n int, r *rand.Rand) *Slice[T] {
+	n = max(0, min(n, s.Len()))
+	if n == 0 {
+		return nil
+	}
+
+	// Shuffle a copy of s, stopping after the first n positions
+	// (a partial Fisher-Yates shuffle).
+	result := Make[T](s.Len(), s.Len())
+	s.Copy(result)
+	for i := 0; i < n; i++ {
+		j := i + intN(r, s.Len()-i)
+		result.Swap(i, j)
+	}
+	return result.Subslice(0, n).Clip()
+}
+
+func intN(r *rand.Rand, n int) int {
+	if r == nil {
+		return rand.IntN(n)
+	}
+	return r.IntN(n)
+}
+
+// ReservoirSample returns up to k elements of seq,
+// chosen uniformly at random, in a new slice.
+// If seq produces fewer than k elements, all of them are returned.
+//
+// It uses "Algorithm R,"
+// which needs only O(k) memory no matter how long seq is.
+// Random numbers come from r.
+// If r is nil, the global source in math/rand/v2 is used.
+func ReservoirSample[T any](seq iter.Seq[T], k int, r *rand.Rand) *Slice[T] {
+	if k <= 0 {
+		return nil
+	}
+
+	var (
+		result = Make[T](0, k)
+		seen   int
+	)
+	for v := range seq {
+		seen++
+		if result.Len() < k {
+			result = result.Append(v)
+			continue
+		}
+
+		// Keep v with probability k/seen,
+		// displacing a randomly chosen element of the reservoir.
+		if j := intN(r, seen); j < k {
+			*result.AtPtr(j) = v
+		}
+	}
+	if result.Len() == 0 {
+		return nil
+	}
+	return result
+}
+
+// WeightedSample returns one element of s, chosen at random
+// with probability proportional to the corresponding element of weights.
+// It returns false if s is empty
+// (or if all the weights are zero).
+// It panics if s and weights have different lengths
+// or if any weight is negative.
+//
+// Random numbers come from r.
+// If r is nil, the global source in math/rand/v2 is used.
+func WeightedSample[T any](s *Slice[T], weights *Slice[float64], r *rand.Rand) (T, bool) {
+	MustSameLength(s, weights)
+
+	var (
+		zero  T
+		cum   = make([]float64, weights.Len())
+		total float64
+	)
+	for i := range cum {
+		w := weights.At(i)
+		if w < 0 {
+			panic(fmt.Sprintf("negative weight at index %d: %v", i, w))
+		}
+		total += w
+		cum[i] = total
+	}
+	if total == 0 {
+		return zero, false
+	}
+
+	// Find the first cumulative weight exceeding a random point in [0, total).
+	var (
+		u    = total * float64Of(r)
+		i, _ = slices.BinarySearchFunc(cum, u, func(c, u float64) int {
+			if c <= u {
+				return -1
+			}
+			return 1
+		})
+	)
+	return s.At(i), true
+}
+
+func float64Of(r *rand.Rand) float64 {
+	if r == nil {
+		return rand.Float64()
+	}
+	return r.Float64()
+}
@@ -0,0 +1,113 @@
+package slice
+
+import (
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestSampleN(t *testing.T) {
+	var (
+		s   = Iterate(0, 10, func(x int) int { return x + 1 })
+		r   = rand.New(rand.NewPCG(1, 2))
+		got = elems(SampleN(s, 4, r))
+	)
+	if want := []int{7, 6, 2, 8}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	for trial := 0; trial < 100; trial++ {
+		got := elems(SampleN(s, 5, nil))
+		if len(got) != 5 {
+			t.Fatalf("got %d elements, want 5", len(got))
+		}
+		seen := make(map[int]bool)
+		for _, v := range got {
+			if seen[v] {
+				t.Fatalf("%d appears twice in %v", v, got)
+			}
+			seen[v] = true
+		}
+	}
+
+	got = elems(SampleN(s, 20, r))
+	slices.Sort(got)
+	if !slices.Equal(got, elems(s)) {
+		t.Errorf("got %v, want %v", got, elems(s))
+	}
+
+	if l := SampleN(s, 0, r).Len(); l != 0 {
+		t.Errorf("got %d, want 0", l)
+	}
+}
+
+func TestReservoirSample(t *testing.T) {
+	seq := func(n int) iter.Seq[int] {
+		return func(yield func(int) bool) {
+			for i := 0; i < n; i++ {
+				if !yield(i) {
+					return
+				}
+			}
+		}
+	}
+
+	r := rand.New(rand.NewPCG(1, 2))
+	got := elems(ReservoirSample(seq(100), 5, r))
+	if want := []int{38, 25, 48, 3, 42}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	r = rand.New(rand.NewPCG(1, 2))
+	again := elems(ReservoirSample(seq(100), 5, r))
+	if !slices.Equal(got, again) {
+		t.Errorf("same seed produced %v and then %v", got, again)
+	}
+
+	got = elems(ReservoirSample(seq(3), 5, r))
+	if !slices.Equal(got, []int{0, 1, 2}) {
+		t.Errorf("got %v, want [0 1 2]", got)
+	}
+
+	if l := ReservoirSample(seq(0), 5, r).Len(); l != 0 {
+		t.Errorf("got %d, want 0", l)
+	}
+}
+
+func TestWeightedSample(t *testing.T) {
+	var (
+		s       = From("a", "b", "c", "d")
+		weights = From(1.0, 2.0, 0.0, 7.0)
+		r       = rand.New(rand.NewPCG(1, 2))
+		counts  = make(map[string]int)
+	)
+	const draws = 10000
+	for i := 0; i < draws; i++ {
+		v, ok := WeightedSample(s, weights, r)
+		if !ok {
+			t.Fatal("WeightedSample failed")
+		}
+		counts[v]++
+	}
+	for i, want := range []float64{0.1, 0.2, 0, 0.7} {
+		var (
+			v   = s.At(i)
+			got = float64(counts[v]) / draws
+		)
+		if got < want-0.02 || got > want+0.02 {
+			t.Errorf("%s: got frequency %v, want about %v", v, got, want)
+		}
+	}
+
+	if _, ok := WeightedSample[string](nil, nil, r); ok {
+		t.Error("sampling from an empty slice should fail")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	WeightedSample(s, From(1.0, 2.0), r)
+}
@@ -0,0 +1,66 @@
+package slice
+
+import "sync"
+
+// Accumulate returns the running results of combining the elements of s with op:
+// element 0 of the result is s[0],
+// element 1 is op(s[0], s[1]),
+// element 2 is op(op(s[0], s[1]), s[2]),
+// and so on.
+// With addition as op, this produces prefix sums.
+func Accumulate[T any](s *Slice[T], op func(a, b T) T) *Slice[T] {
+	n := s.Len()
+	if n == 0 {
+		return nil
+	}
+	result := Make[T](n, n)
+	result.storage[0] = s.At(0)
+	for i := 1; i < n; i++ {
+		result.storage[i] = op(result.storage[i-1], s.At(i))
+	}
+	return result
+}
+
+// ParallelReduce divides s into as many as workers contiguous chunks
+// and reduces each one in its own goroutine,
+// starting from identity and applying f to each element in turn.
+// It then combines the partial results, in order, with combine.
+//
+// For the result to be the same as a sequential reduction,
+// combine must be associative,
+// and identity must be an identity value for it
+// (like 0 for addition).
+// Since f is called concurrently on different chunks,
+// it must be safe for concurrent use.
+// It panics if workers is less than 1.
+func ParallelReduce[T, U any](s *Slice[T], workers int, identity U, f func(U, T) U, combine func(U, U) U) U {
+	if workers < 1 {
+		panic("number of workers must be positive")
+	}
+
+	var (
+		n        = s.Len()
+		size     = max(1, (n+workers-1)/workers)
+		partials = make([]U, (n+size-1)/size)
+		wg       sync.WaitGroup
+	)
+	for i := range partials {
+		chunk := s.Subslice(i*size, min((i+1)*size, n))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			acc := identity
+			for j := 0; j < chunk.Len(); j++ {
+				acc = f(acc, chunk.At(j))
+			}
+			partials[i] = acc
+		}()
+	}
+	wg.Wait()
+
+	result := identity
+	for _, p := range partials {
+		result = combine(result, p)
+	}
+	return result
+}
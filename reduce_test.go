@@ -0,0 +1,62 @@
+package slice
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAccumulate(t *testing.T) {
+	s := From(1, 2, 3, 4, 5)
+
+	sums := Accumulate(s, func(a, b int) int { return a + b })
+	if got := elems(sums); !slices.Equal(got, []int{1, 3, 6, 10, 15}) {
+		t.Errorf("got %v, want [1 3 6 10 15]", got)
+	}
+
+	products := Accumulate(s, func(a, b int) int { return a * b })
+	if got := elems(products); !slices.Equal(got, []int{1, 2, 6, 24, 120}) {
+		t.Errorf("got %v, want [1 2 6 24 120]", got)
+	}
+
+	if l := Accumulate(nil, func(a, b int) int { return a + b }).Len(); l != 0 {
+		t.Errorf("got %d, want 0", l)
+	}
+}
+
+func TestParallelReduce(t *testing.T) {
+	var (
+		s   = Iterate(1, 1000, func(x int) int { return x + 1 })
+		add = func(a, b int) int { return a + b }
+	)
+	want := 0
+	for i := 0; i < s.Len(); i++ {
+		want += s.At(i)
+	}
+
+	for _, workers := range []int{1, 2, 3, 7, 1000, 2000} {
+		if got := ParallelReduce(s, workers, 0, add, add); got != want {
+			t.Errorf("%d workers: got %d, want %d", workers, got, want)
+		}
+	}
+
+	// Concatenation is associative but not commutative,
+	// so this checks that the partial results are combined in order.
+	var (
+		letters = FromString("abcdefghijklmnopqrstuvwxyz")
+		concat  = ParallelReduce(letters, 4, "", func(acc string, b byte) string { return acc + string(b) }, func(a, b string) string { return a + b })
+	)
+	if concat != "abcdefghijklmnopqrstuvwxyz" {
+		t.Errorf("got %q", concat)
+	}
+
+	if got := ParallelReduce(nil, 4, 0, add, add); got != 0 {
+		t.Errorf("got %d, want 0", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	ParallelReduce(s, 0, 0, add, add)
+}
@@ -0,0 +1,78 @@
+package slice
+
+// RingBuffer is a fixed-capacity first-in, first-out collection.
+// Its elements live in a single Slice that is treated as a ring,
+// with the oldest element at index head
+// and later ones following it, wrapping around at the end.
+// When the buffer is full,
+// Push overwrites the oldest element.
+//
+// Create a RingBuffer with NewRingBuffer.
+// The zero value has no capacity,
+// and Push panics on it.
+type RingBuffer[T any] struct {
+	items        *Slice[T]
+	head, length int
+}
+
+// NewRingBuffer creates an empty RingBuffer with the given capacity.
+func NewRingBuffer[T any](capacity int) *RingBuffer[T] {
+	if capacity <= 0 {
+		panic("capacity must be positive")
+	}
+	return &RingBuffer[T]{items: Make[T](capacity, capacity)}
+}
+
+// Push adds v as the newest element of the buffer.
+// If the buffer is full,
+// the oldest element is discarded to make room.
+func (r *RingBuffer[T]) Push(v T) {
+	n := r.Cap()
+	if n == 0 {
+		panic("RingBuffer must be created with NewRingBuffer")
+	}
+	*r.items.AtPtr((r.head + r.length) % n) = v
+	if r.length < n {
+		r.length++
+	} else {
+		r.head = (r.head + 1) % n
+	}
+}
+
+// Pop removes and returns the oldest element of the buffer.
+// It returns false if the buffer is empty.
+func (r *RingBuffer[T]) Pop() (T, bool) {
+	var zero T
+	if r.length == 0 {
+		return zero, false
+	}
+	p := r.items.AtPtr(r.head)
+	v := *p
+	*p = zero
+	r.head = (r.head + 1) % r.Cap()
+	r.length--
+	return v, true
+}
+
+// Len is the number of elements in the buffer.
+func (r *RingBuffer[T]) Len() int {
+	return r.length
+}
+
+// Cap is the maximum number of elements the buffer can hold.
+func (r *RingBuffer[T]) Cap() int {
+	return r.items.Len()
+}
+
+// Elements returns a new slice
+// containing the buffer's elements from oldest to newest.
+func (r *RingBuffer[T]) Elements() *Slice[T] {
+	if r.length == 0 {
+		return nil
+	}
+	result := Make[T](r.length, r.length)
+	for i := 0; i < r.length; i++ {
+		result.storage[i] = r.items.CircularAt(r.head + i)
+	}
+	return result
+}
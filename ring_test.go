@@ -0,0 +1,57 @@
+package slice
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRingBuffer(t *testing.T) {
+	r := NewRingBuffer[int](3)
+	if c := r.Cap(); c != 3 {
+		t.Errorf("got %d, want 3", c)
+	}
+	if _, ok := r.Pop(); ok {
+		t.Error("popping an empty buffer should fail")
+	}
+
+	r.Push(1)
+	r.Push(2)
+	if got := elems(r.Elements()); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("got %v, want [1 2]", got)
+	}
+
+	for i := 3; i <= 7; i++ {
+		r.Push(i)
+	}
+	if l := r.Len(); l != 3 {
+		t.Errorf("got %d, want 3", l)
+	}
+	if got := elems(r.Elements()); !slices.Equal(got, []int{5, 6, 7}) {
+		t.Errorf("got %v, want [5 6 7]", got)
+	}
+
+	for _, want := range []int{5, 6} {
+		if v, ok := r.Pop(); !ok || v != want {
+			t.Errorf("got %d, %v; want %d, true", v, ok, want)
+		}
+	}
+	r.Push(8)
+	r.Push(9)
+	if got := elems(r.Elements()); !slices.Equal(got, []int{7, 8, 9}) {
+		t.Errorf("got %v, want [7 8 9]", got)
+	}
+}
+
+func TestRingBufferZeroValue(t *testing.T) {
+	var r RingBuffer[int]
+	if _, ok := r.Pop(); ok {
+		t.Error("Pop on the zero value should report an empty buffer")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	r.Push(1)
+}
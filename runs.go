@@ -0,0 +1,147 @@
+package slice
+
+// Run is a run of Count consecutive elements, all equal to Value.
+type Run[T any] struct {
+	Value T
+	Count int
+}
+
+// RunLengthEncode collapses each run of consecutive equal elements in s
+// into a single Run.
+func RunLengthEncode[T comparable](s *Slice[T]) *Slice[Run[T]] {
+	var result *Slice[Run[T]]
+	for i := 0; i < s.Len(); {
+		var (
+			v = s.At(i)
+			j = i + 1
+		)
+		for j < s.Len() && s.At(j) == v {
+			j++
+		}
+		result = result.Append(Run[T]{Value: v, Count: j - i})
+		i = j
+	}
+	return result
+}
+
+// RunLengthDecode is the inverse of RunLengthEncode.
+// It expands each Run into Count copies of its Value.
+func RunLengthDecode[T any](runs *Slice[Run[T]]) *Slice[T] {
+	n := 0
+	for i := 0; i < runs.Len(); i++ {
+		n += runs.At(i).Count
+	}
+	if n == 0 {
+		return nil
+	}
+
+	result := Make[T](0, n)
+	for i := 0; i < runs.Len(); i++ {
+		r := runs.At(i)
+		for j := 0; j < r.Count; j++ {
+			result = result.Append(r.Value)
+		}
+	}
+	return result
+}
+
+// GroupConsecutive splits s into runs of consecutive elements
+// having the same key.
+// Each run is a Subslice sharing storage with s.
+// Elements with the same key that are not adjacent
+// end up in separate groups.
+func GroupConsecutive[T any, K comparable](s *Slice[T], key func(T) K) []*Slice[T] {
+	var result []*Slice[T]
+	for i := 0; i < s.Len(); {
+		var (
+			k = key(s.At(i))
+			j = i + 1
+		)
+		for j < s.Len() && key(s.At(j)) == k {
+			j++
+		}
+		result = append(result, s.Subslice(i, j))
+		i = j
+	}
+	return result
+}
+
+// DedupeWithCounts collapses each run of consecutive equal elements in s
+// into a single value,
+// returning the values in one slice
+// and the length of each run in another.
+// This is the same information as RunLengthEncode,
+// but in two parallel slices instead of one slice of Runs.
+func DedupeWithCounts[T comparable](s *Slice[T]) (*Slice[T], *Slice[int]) {
+	var (
+		values *Slice[T]
+		counts *Slice[int]
+	)
+	for i := 0; i < s.Len(); {
+		var (
+			v = s.At(i)
+			j = i + 1
+		)
+		for j < s.Len() && s.At(j) == v {
+			j++
+		}
+		values = values.Append(v)
+		counts = counts.Append(j - i)
+		i = j
+	}
+	return values, counts
+}
+
+// CountRuns returns the number of maximal runs
+// of consecutive equal elements in s.
+// For example, a a b b b a has three runs.
+func CountRuns[T comparable](s *Slice[T]) int {
+	return CountRunsFunc(s, func(a, b T) bool { return a == b })
+}
+
+// CountRunsFunc is like CountRuns but compares elements with eq.
+func CountRunsFunc[T any](s *Slice[T], eq func(a, b T) bool) int {
+	if s.Len() == 0 {
+		return 0
+	}
+	n := 1
+	for i := 1; i < s.Len(); i++ {
+		if !eq(s.At(i-1), s.At(i)) {
+			n++
+		}
+	}
+	return n
+}
+
+// Uniq removes duplicate elements from s in place,
+// for s sorted in increasing order,
+// and returns the shortened slice.
+// Sorting puts equal elements next to each other,
+// so a single pass that collapses each run of consecutive equal elements
+// removes all duplicates.
+// On unsorted input, only adjacent duplicates are removed.
+// The elements freed at the end of s are set to their zero value,
+// as in Truncate.
+//
+// This would be a method,
+// but Go methods can't require T to be comparable
+// when the type Slice[T] doesn't.
+func Uniq[T comparable](s *Slice[T]) *Slice[T] {
+	return UniqFunc(s, func(a, b T) bool { return a == b })
+}
+
+// UniqFunc is like Uniq but compares elements with eq.
+func UniqFunc[T any](s *Slice[T], eq func(a, b T) bool) *Slice[T] {
+	if s.Len() == 0 {
+		return s
+	}
+	w := s.storage[s.offset : s.offset+s.length]
+	n := 1
+	for i := 1; i < len(w); i++ {
+		if !eq(w[n-1], w[i]) {
+			w[n] = w[i]
+			n++
+		}
+	}
+	return s.Truncate(n)
+}
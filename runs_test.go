@@ -0,0 +1,130 @@
+package slice
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRunLengthEncode(t *testing.T) {
+	cases := []struct {
+		items []string
+		want  []Run[string]
+	}{
+		{items: nil, want: nil},
+		{items: []string{"a", "a", "a"}, want: []Run[string]{{"a", 3}}},
+		{items: []string{"a", "b", "c"}, want: []Run[string]{{"a", 1}, {"b", 1}, {"c", 1}}},
+		{items: []string{"a", "a", "b", "a", "c", "c"}, want: []Run[string]{{"a", 2}, {"b", 1}, {"a", 1}, {"c", 2}}},
+	}
+	for _, c := range cases {
+		runs := RunLengthEncode(FromArray(c.items))
+		if got := elems(runs); !slices.Equal(got, c.want) {
+			t.Errorf("RunLengthEncode(%v): got %v, want %v", c.items, got, c.want)
+		}
+		if got := elems(RunLengthDecode(runs)); !slices.Equal(got, c.items) {
+			t.Errorf("RunLengthDecode(%v): got %v, want %v", c.want, got, c.items)
+		}
+	}
+}
+
+func TestGroupConsecutive(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+
+	groups := GroupConsecutive(From(2, 4, 1, 3, 5, 6, 7, 9), isEven)
+	want := [][]int{{2, 4}, {1, 3, 5}, {6}, {7, 9}}
+	if len(groups) != len(want) {
+		t.Fatalf("got %d groups, want %d", len(groups), len(want))
+	}
+	for i, g := range groups {
+		if got := elems(g); !slices.Equal(got, want[i]) {
+			t.Errorf("group %d: got %v, want %v", i, got, want[i])
+		}
+	}
+
+	groups = GroupConsecutive(From(2, 4, 6), isEven)
+	if len(groups) != 1 || !slices.Equal(elems(groups[0]), []int{2, 4, 6}) {
+		t.Errorf("got %d groups, want a single group [2 4 6]", len(groups))
+	}
+
+	if groups := GroupConsecutive(nil, isEven); len(groups) != 0 {
+		t.Errorf("got %d groups, want 0", len(groups))
+	}
+}
+
+func TestDedupeWithCounts(t *testing.T) {
+	cases := []struct {
+		items, wantValues []string
+		wantCounts        []int
+	}{
+		{items: []string{"a", "b", "c"}, wantValues: []string{"a", "b", "c"}, wantCounts: []int{1, 1, 1}},
+		{items: []string{"a", "a", "a", "a", "b", "b", "a", "a", "a"}, wantValues: []string{"a", "b", "a"}, wantCounts: []int{4, 2, 3}},
+		{items: nil},
+	}
+	for _, c := range cases {
+		values, counts := DedupeWithCounts(FromArray(c.items))
+		if values.Len() != counts.Len() {
+			t.Errorf("%v: got %d values but %d counts", c.items, values.Len(), counts.Len())
+		}
+		if got := elems(values); !slices.Equal(got, c.wantValues) {
+			t.Errorf("%v: got values %v, want %v", c.items, got, c.wantValues)
+		}
+		if got := elems(counts); !slices.Equal(got, c.wantCounts) {
+			t.Errorf("%v: got counts %v, want %v", c.items, got, c.wantCounts)
+		}
+	}
+}
+
+func TestCountRuns(t *testing.T) {
+	cases := []struct {
+		items []string
+		want  int
+	}{
+		{items: nil, want: 0},
+		{items: []string{"a"}, want: 1},
+		{items: []string{"a", "a", "a"}, want: 1},
+		{items: []string{"a", "b", "c", "d"}, want: 4},
+		{items: []string{"a", "a", "b", "b", "b", "a"}, want: 3},
+	}
+	for _, c := range cases {
+		if got := CountRuns(FromArray(c.items)); got != c.want {
+			t.Errorf("CountRuns(%v): got %d, want %d", c.items, got, c.want)
+		}
+	}
+}
+
+func TestCountRunsFunc(t *testing.T) {
+	sameParity := func(a, b int) bool { return a%2 == b%2 }
+	if got := CountRunsFunc(From(1, 3, 2, 4, 6, 5), sameParity); got != 3 {
+		t.Errorf("got %d, want 3", got)
+	}
+}
+
+func TestUniq(t *testing.T) {
+	s := From(1, 1, 2, 3, 3, 3, 4, 5, 5)
+	got := Uniq(s)
+	if want := []int{1, 2, 3, 4, 5}; !slices.Equal(elems(got), want) {
+		t.Errorf("got %v, want %v", elems(got), want)
+	}
+	for i := got.Len(); i < s.Len(); i++ {
+		if v := s.At(i); v != 0 {
+			t.Errorf("element %d of the freed tail is %d, want 0", i, v)
+		}
+	}
+
+	// Unsorted input: only adjacent duplicates go.
+	got = Uniq(From(1, 1, 2, 1, 2, 2))
+	if want := []int{1, 2, 1, 2}; !slices.Equal(elems(got), want) {
+		t.Errorf("got %v, want %v", elems(got), want)
+	}
+
+	if got := Uniq[int](nil); got != nil {
+		t.Errorf("got %v, want nil", elems(got))
+	}
+}
+
+func TestUniqFunc(t *testing.T) {
+	got := UniqFunc(From("a", "A", "b", "B", "b", "c"), strings.EqualFold)
+	if want := []string{"a", "b", "c"}; !slices.Equal(elems(got), want) {
+		t.Errorf("got %v, want %v", elems(got), want)
+	}
+}
@@ -0,0 +1,163 @@
+package slice
+
+// AdjacentFind returns the index of the first element of s
+// that is equal to the one after it,
+// or -1 if there is none.
+func AdjacentFind[T comparable](s *Slice[T]) int {
+	return AdjacentFindFunc(s, func(a, b T) bool { return a == b })
+}
+
+// AdjacentFindFunc is like AdjacentFind
+// but returns the index of the first element i
+// for which eq(s[i], s[i+1]) is true.
+func AdjacentFindFunc[T any](s *Slice[T], eq func(a, b T) bool) int {
+	for i := 0; i+1 < s.Len(); i++ {
+		if eq(s.At(i), s.At(i+1)) {
+			return i
+		}
+	}
+	return -1
+}
+
+// CountDistinct returns the number of distinct values among the elements of s.
+func CountDistinct[T comparable](s *Slice[T]) int {
+	return CountDistinctBy(s, func(v T) T { return v })
+}
+
+// CountDistinctBy returns the number of distinct keys
+// among the elements of s,
+// where key computes the key for each element.
+func CountDistinctBy[T any, K comparable](s *Slice[T], key func(T) K) int {
+	seen := make(map[K]struct{})
+	for i := 0; i < s.Len(); i++ {
+		seen[key(s.At(i))] = struct{}{}
+	}
+	return len(seen)
+}
+
+// Distinct returns a new slice containing the elements of s
+// with duplicates removed.
+// It keeps the first occurrence of each value,
+// and the survivors stay in their original order.
+func Distinct[T comparable](s *Slice[T]) *Slice[T] {
+	var (
+		result *Slice[T]
+		seen   = make(map[T]struct{})
+	)
+	for i := 0; i < s.Len(); i++ {
+		v := s.At(i)
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		result = result.Append(v)
+	}
+	return result
+}
+
+// DistinctLast is like Distinct
+// but keeps the last occurrence of each value instead of the first.
+func DistinctLast[T comparable](s *Slice[T]) *Slice[T] {
+	last := make(map[T]int)
+	for i := 0; i < s.Len(); i++ {
+		last[s.At(i)] = i
+	}
+
+	var result *Slice[T]
+	for i := 0; i < s.Len(); i++ {
+		if v := s.At(i); last[v] == i {
+			result = result.Append(v)
+		}
+	}
+	return result
+}
+
+// Positions maps each distinct value in s
+// to the indices where it appears, in increasing order.
+func Positions[T comparable](s *Slice[T]) map[T][]int {
+	result := make(map[T][]int)
+	for i := 0; i < s.Len(); i++ {
+		v := s.At(i)
+		result[v] = append(result[v], i)
+	}
+	return result
+}
+
+// FindIndexAll returns the indices of all the elements of s satisfying pred,
+// in increasing order.
+func FindIndexAll[T any](s *Slice[T], pred func(T) bool) []int {
+	var result []int
+	for i := 0; i < s.Len(); i++ {
+		if pred(s.At(i)) {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+// SplitOn splits s around each occurrence of the sequence sep,
+// like bytes.Split.
+// It returns the pieces between the occurrences,
+// each a Subslice sharing storage with s.
+// Like the pieces from bytes.Split,
+// each one is clipped (see [Slice.Clip]),
+// so appending to it cannot overwrite the rest of s.
+// Occurrences of sep do not overlap:
+// the search for each one begins after the end of the previous one.
+// Note that if sep occurs at the start or end of s,
+// or twice in a row,
+// there will be empty pieces.
+//
+// If sep is empty,
+// SplitOn splits s into one-element pieces.
+func SplitOn[T comparable](s, sep *Slice[T]) []*Slice[T] {
+	if sep.Len() == 0 {
+		var result []*Slice[T]
+		for i := 0; i < s.Len(); i++ {
+			result = append(result, s.Subslice(i, i+1).Clip())
+		}
+		return result
+	}
+
+	var result []*Slice[T]
+	start := 0
+	for {
+		i := indexSubslice(s, sep, start)
+		if i < 0 {
+			return append(result, s.Subslice(start, s.Len()).Clip())
+		}
+		result = append(result, s.Subslice(start, i).Clip())
+		start = i + sep.Len()
+	}
+}
+
+// indexSubslice returns the index of the first occurrence of sub in s
+// at or after index start,
+// or -1 if there is none.
+func indexSubslice[T comparable](s, sub *Slice[T], start int) int {
+outer:
+	for i := start; i+sub.Len() <= s.Len(); i++ {
+		for j := 0; j < sub.Len(); j++ {
+			if s.At(i+j) != sub.At(j) {
+				continue outer
+			}
+		}
+		return i
+	}
+	return -1
+}
+
+// CountSubslice returns the number of non-overlapping occurrences
+// of the sequence sub in s,
+// like strings.Count.
+// If sub is empty, the result is s.Len()+1.
+func CountSubslice[T comparable](s, sub *Slice[T]) int {
+	if sub.Len() == 0 {
+		return s.Len() + 1
+	}
+	n := 0
+	for i := indexSubslice(s, sub, 0); i >= 0; i = indexSubslice(s, sub, i+sub.Len()) {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,176 @@
+package slice
+
+import (
+	"bytes"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestAdjacentFind(t *testing.T) {
+	cases := []struct {
+		items []int
+		want  int
+	}{
+		{items: []int{1, 1, 2, 3}, want: 0},
+		{items: []int{1, 2, 2, 3, 3}, want: 1},
+		{items: []int{1, 2, 3, 3}, want: 2},
+		{items: []int{1, 2, 3, 1}, want: -1},
+		{items: []int{1}, want: -1},
+		{items: nil, want: -1},
+	}
+	for _, c := range cases {
+		if got := AdjacentFind(FromArray(c.items)); got != c.want {
+			t.Errorf("AdjacentFind(%v): got %d, want %d", c.items, got, c.want)
+		}
+	}
+}
+
+func TestAdjacentFindFunc(t *testing.T) {
+	// Find the first place where the sequence stops increasing.
+	got := AdjacentFindFunc(From(1, 3, 5, 4, 6), func(a, b int) bool { return b < a })
+	if got != 2 {
+		t.Errorf("got %d, want 2", got)
+	}
+}
+
+func TestCountDistinct(t *testing.T) {
+	cases := []struct {
+		items []string
+		want  int
+	}{
+		{items: []string{"a", "a", "a"}, want: 1},
+		{items: []string{"a", "b", "c"}, want: 3},
+		{items: []string{"a", "b", "a", "c", "b"}, want: 3},
+		{items: nil, want: 0},
+	}
+	for _, c := range cases {
+		if got := CountDistinct(FromArray(c.items)); got != c.want {
+			t.Errorf("CountDistinct(%v): got %d, want %d", c.items, got, c.want)
+		}
+	}
+}
+
+func TestCountDistinctBy(t *testing.T) {
+	got := CountDistinctBy(From("apple", "avocado", "banana", "cherry", "blueberry"), func(s string) byte { return s[0] })
+	if got != 3 {
+		t.Errorf("got %d, want 3", got)
+	}
+}
+
+func TestDistinct(t *testing.T) {
+	s := From("a", "b", "a", "c", "b", "d")
+
+	if got := elems(Distinct(s)); !slices.Equal(got, []string{"a", "b", "c", "d"}) {
+		t.Errorf("Distinct: got %v, want [a b c d]", got)
+	}
+	if got := elems(DistinctLast(s)); !slices.Equal(got, []string{"a", "c", "b", "d"}) {
+		t.Errorf("DistinctLast: got %v, want [a c b d]", got)
+	}
+
+	if l := Distinct[string](nil).Len(); l != 0 {
+		t.Errorf("got %d, want 0", l)
+	}
+	if l := DistinctLast[string](nil).Len(); l != 0 {
+		t.Errorf("got %d, want 0", l)
+	}
+}
+
+func TestPositions(t *testing.T) {
+	got := Positions(From("a", "b", "a", "c", "b", "a"))
+	want := map[string][]int{
+		"a": {0, 2, 5},
+		"b": {1, 4},
+		"c": {3},
+	}
+	if !maps.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	got = Positions[string](nil)
+	if got == nil || len(got) != 0 {
+		t.Errorf("got %v, want an empty map", got)
+	}
+}
+
+func TestFindIndexAll(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+
+	if got := FindIndexAll(From(3, 4, 7, 8, 10, 11), isEven); !slices.Equal(got, []int{1, 3, 4}) {
+		t.Errorf("got %v, want [1 3 4]", got)
+	}
+	if got := FindIndexAll(From(1, 3, 5), isEven); len(got) != 0 {
+		t.Errorf("got %v, want []", got)
+	}
+	if got := FindIndexAll(nil, isEven); len(got) != 0 {
+		t.Errorf("got %v, want []", got)
+	}
+}
+
+func TestSplitOn(t *testing.T) {
+	cases := []struct {
+		s, sep string
+		want   []string
+	}{
+		{s: "a, b, c", sep: ", ", want: []string{"a", "b", "c"}},
+		{s: ", a, b, ", sep: ", ", want: []string{"", "a", "b", ""}},
+		{s: "a, , b", sep: ", ", want: []string{"a", "", "b"}},
+		{s: "a,,b", sep: ",", want: []string{"a", "", "b"}},
+		{s: "abc", sep: ", ", want: []string{"abc"}},
+		{s: "aaa", sep: "aa", want: []string{"", "a"}},
+		{s: "", sep: ",", want: []string{""}},
+		{s: "abc", sep: "", want: []string{"a", "b", "c"}},
+	}
+	for _, c := range cases {
+		var got []string
+		for _, piece := range SplitOn(FromString(c.s), FromString(c.sep)) {
+			got = append(got, String(piece))
+		}
+		if !slices.Equal(got, c.want) {
+			t.Errorf("SplitOn(%q, %q): got %q, want %q", c.s, c.sep, got, c.want)
+		}
+		if want := bytes.Split([]byte(c.s), []byte(c.sep)); len(got) != len(want) {
+			t.Errorf("SplitOn(%q, %q): got %d pieces, bytes.Split gives %d", c.s, c.sep, len(got), len(want))
+		}
+	}
+
+	var (
+		s      = FromString("a-b")
+		pieces = SplitOn(s, FromString("-"))
+	)
+	*pieces[1].AtPtr(0) = 'x'
+	if got := String(s); got != "a-x" {
+		t.Errorf(`pieces should share storage with s; got %q, want "a-x"`, got)
+	}
+
+	s = From[byte](1, 0, 2)
+	SplitOn(s, From[byte](0))[0].Append(7)
+	if got := elems(s); !slices.Equal(got, []byte{1, 0, 2}) {
+		t.Errorf("appending to a piece should not overwrite s; got %v, want [1 0 2]", got)
+	}
+}
+
+func TestCountSubslice(t *testing.T) {
+	cases := []struct {
+		s, sub string
+		want   int
+	}{
+		{s: "cheese", sub: "e", want: 3},
+		{s: "aaaa", sub: "aa", want: 2},
+		{s: "ababab", sub: "aba", want: 1},
+		{s: "abc", sub: "x", want: 0},
+		{s: "abc", sub: "abcd", want: 0},
+		{s: "five", sub: "", want: 5},
+		{s: "", sub: "", want: 1},
+	}
+	for _, c := range cases {
+		got := CountSubslice(FromString(c.s), FromString(c.sub))
+		if got != c.want {
+			t.Errorf("CountSubslice(%q, %q): got %d, want %d", c.s, c.sub, got, c.want)
+		}
+		if want := strings.Count(c.s, c.sub); got != want {
+			t.Errorf("CountSubslice(%q, %q): got %d, strings.Count gives %d", c.s, c.sub, got, want)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package slice
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
 // Slice works just like Go slices,
 // with the underlying mechanisms made explicit.
@@ -71,6 +74,24 @@ func FromArray[T any](a []T) *Slice[T] {
 	}
 }
 
+// FromWithCap is like From,
+// but the resulting slice has the given capacity,
+// which must be at least len(items).
+// It is the same as writing
+//
+//	s := make([]T, len(items), capacity)
+//	copy(s, items)
+//
+// and leaves room for later Appends without reallocating.
+func FromWithCap[T any](capacity int, items ...T) *Slice[T] {
+	if capacity < len(items) {
+		panic(fmt.Sprintf("capacity too small: %d < %d", capacity, len(items)))
+	}
+	result := Make[T](len(items), capacity)
+	copy(result.storage, items)
+	return result
+}
+
 // Len is len(s).
 func (s *Slice[T]) Len() int {
 	if s == nil {
@@ -141,6 +162,75 @@ func (s *Slice[T]) At(n int) T {
 	return s.storage[s.offset+n]
 }
 
+// AtPtr is like &s[n].
+// It returns a pointer into the underlying storage,
+// so writing through it changes the element in s
+// and in every other slice sharing that storage.
+//
+// Beware: if a later Append has to reallocate,
+// the resulting slice gets new storage
+// and the pointer no longer refers to any of its elements.
+func (s *Slice[T]) AtPtr(n int) *T {
+	s.checkIndex(n)
+	return &s.storage[s.offset+n]
+}
+
+// ErrIndexOutOfRange is the error returned by Get
+// for an index that is negative or too large.
+var ErrIndexOutOfRange = errors.New("index out of range")
+
+// Get is like At but returns an error instead of panicking
+// when n is out of range.
+// The error wraps ErrIndexOutOfRange.
+func (s *Slice[T]) Get(n int) (T, error) {
+	v, ok := s.TryAt(n)
+	if !ok {
+		return v, fmt.Errorf("%w: %d (length %d)", ErrIndexOutOfRange, n, s.Len())
+	}
+	return v, nil
+}
+
+// CircularAt is like At but treats s as a ring:
+// any index, including a negative one, is reduced modulo s.Len().
+// So CircularAt(-1) is the last element
+// and CircularAt(s.Len()) is the first.
+// It panics if s is empty.
+func (s *Slice[T]) CircularAt(n int) T {
+	l := s.Len()
+	if l == 0 {
+		panic("index into empty slice")
+	}
+	n %= l
+	if n < 0 {
+		n += l
+	}
+	return s.storage[s.offset+n]
+}
+
+// checkIndex panics if n is not a valid index into s,
+// the same way At does.
+func (s *Slice[T]) checkIndex(n int) {
+	if n < 0 {
+		panic("index must not be negative")
+	}
+	if n >= s.Len() {
+		panic(fmt.Sprintf("index out of range: %d > %d", n, s.Len()))
+	}
+}
+
+// TryAt is like the "comma-ok" form of s[n]
+// (which Go has for maps but not for slices).
+// It returns the element and true if n is in range,
+// and the zero value and false otherwise.
+// It never panics.
+func (s *Slice[T]) TryAt(n int) (T, bool) {
+	if n < 0 || n >= s.Len() {
+		var zero T
+		return zero, false
+	}
+	return s.storage[s.offset+n], true
+}
+
 // Clear is like clear(s) (added in Go 1.21).
 func (s *Slice[T]) Clear() {
 	if s == nil {
@@ -154,6 +244,18 @@ func (s *Slice[T]) Clear() {
 	}
 }
 
+// FillWith sets each element of s to f(i),
+// where i is the element's index in s.
+func (s *Slice[T]) FillWith(f func(i int) T) {
+	if s == nil {
+		return
+	}
+
+	for i := 0; i < s.length; i++ {
+		s.storage[s.offset+i] = f(i)
+	}
+}
+
 // Copy is like copy(dest, s).
 func (s *Slice[T]) Copy(dest *Slice[T]) int {
 	if s == nil || dest == nil {
@@ -169,7 +271,7 @@ func (s *Slice[T]) Append(items ...T) *Slice[T] {
 	if s == nil {
 		return FromArray(items)
 	}
-	if s.offset+s.length+len(items) > s.Cap() {
+	if s.needsRealloc(len(items)) {
 		return s.reallocAppend(items)
 	}
 	copy(s.storage[s.offset+s.length:], items)
@@ -180,6 +282,25 @@ func (s *Slice[T]) Append(items ...T) *Slice[T] {
 	}
 }
 
+// AppendReport is like Append,
+// but also reports whether new storage had to be allocated
+// to hold the result.
+// This makes it possible to observe how Append grows its storage:
+// after a reallocation,
+// a run of subsequent Appends can fit in the spare capacity.
+func (s *Slice[T]) AppendReport(items ...T) (*Slice[T], bool) {
+	if s == nil {
+		return s.Append(items...), len(items) > 0
+	}
+	realloc := s.needsRealloc(len(items))
+	return s.Append(items...), realloc
+}
+
+func (s *Slice[T]) needsRealloc(n int) bool {
+	// Cap already excludes the storage before offset.
+	return s.length+n > s.Cap()
+}
+
 func (s *Slice[T]) reallocAppend(items []T) *Slice[T] {
 	var (
 		newLen  = s.length + len(items)
@@ -1,6 +1,10 @@
 package slice
 
-import "testing"
+import (
+	"errors"
+	"slices"
+	"testing"
+)
 
 func TestSubslice(t *testing.T) {
 	var (
@@ -60,3 +64,179 @@ func TestAppend(t *testing.T) {
 		t.Errorf(`got %q, want "c"`, v)
 	}
 }
+
+func TestTryAt(t *testing.T) {
+	s := From("a", "b", "c")
+	if v, ok := s.TryAt(1); !ok || v != "b" {
+		t.Errorf(`got %q, %v; want "b", true`, v, ok)
+	}
+	for _, n := range []int{-1, 3, 100} {
+		if v, ok := s.TryAt(n); ok || v != "" {
+			t.Errorf(`TryAt(%d): got %q, %v; want "", false`, n, v, ok)
+		}
+	}
+
+	var nilSlice *Slice[string]
+	if v, ok := nilSlice.TryAt(0); ok || v != "" {
+		t.Errorf(`got %q, %v; want "", false`, v, ok)
+	}
+}
+
+func TestAtPtr(t *testing.T) {
+	var (
+		parent = From("a", "b", "c", "d")
+		s      = parent.Subslice(1, 3)
+		p      = s.AtPtr(1)
+	)
+	*p = "x"
+	if v := s.At(1); v != "x" {
+		t.Errorf(`got %q, want "x"`, v)
+	}
+	if v := parent.At(2); v != "x" {
+		t.Errorf(`got %q, want "x"`, v)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	s.AtPtr(2)
+}
+
+func elems[T any](s *Slice[T]) []T {
+	var result []T
+	for i := 0; i < s.Len(); i++ {
+		result = append(result, s.At(i))
+	}
+	return result
+}
+
+func TestCircularAt(t *testing.T) {
+	s := From("a", "b", "c")
+	cases := []struct {
+		n    int
+		want string
+	}{
+		{n: 0, want: "a"},
+		{n: 2, want: "c"},
+		{n: 3, want: "a"},
+		{n: 7, want: "b"},
+		{n: -1, want: "c"},
+		{n: -3, want: "a"},
+		{n: -5, want: "b"},
+	}
+	for _, c := range cases {
+		if v := s.CircularAt(c.n); v != c.want {
+			t.Errorf("CircularAt(%d): got %q, want %q", c.n, v, c.want)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	var empty *Slice[string]
+	empty.CircularAt(0)
+}
+
+func TestFromWithCap(t *testing.T) {
+	s := FromWithCap(5, "a", "b", "c")
+	if l := s.Len(); l != 3 {
+		t.Errorf("got %d, want 3", l)
+	}
+	if c := s.Cap(); c != 5 {
+		t.Errorf("got %d, want 5", c)
+	}
+	if v := s.At(2); v != "c" {
+		t.Errorf(`got %q, want "c"`, v)
+	}
+	if appended := s.Append("d", "e"); !appended.SharesStorageWith(s) {
+		t.Error("Append within capacity should not reallocate")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	FromWithCap(2, "a", "b", "c")
+}
+
+func TestFillWith(t *testing.T) {
+	s := Make[int](5, 5)
+	s.FillWith(func(i int) int { return i })
+	if got := elems(s); !slices.Equal(got, []int{0, 1, 2, 3, 4}) {
+		t.Errorf("got %v, want [0 1 2 3 4]", got)
+	}
+
+	s.Subslice(1, 3).FillWith(func(i int) int { return 10 * (i + 1) })
+	if got := elems(s); !slices.Equal(got, []int{0, 10, 20, 3, 4}) {
+		t.Errorf("got %v, want [0 10 20 3 4]", got)
+	}
+
+	var nilSlice *Slice[int]
+	nilSlice.FillWith(func(i int) int { return i })
+}
+
+func TestAppendReport(t *testing.T) {
+	s := Make[int](0, 3)
+
+	var realloc bool
+	for i := 0; i < 3; i++ {
+		s, realloc = s.AppendReport(i)
+		if realloc {
+			t.Errorf("append %d: unexpected reallocation", i)
+		}
+	}
+	next, realloc := s.AppendReport(3)
+	if !realloc {
+		t.Error("expected a reallocation")
+	}
+	if next.SharesStorageWith(s) {
+		t.Error("expected new storage")
+	}
+	if got := elems(next); !slices.Equal(got, []int{0, 1, 2, 3}) {
+		t.Errorf("got %v, want [0 1 2 3]", got)
+	}
+
+	if _, realloc = next.AppendReport(4); realloc {
+		t.Error("unexpected reallocation after growth")
+	}
+
+	sub := Make[int](8, 8).Subslice(3, 5)
+	grown, realloc := sub.AppendReport(1)
+	if realloc {
+		t.Errorf("unexpected reallocation with Cap %d and new length 3", sub.Cap())
+	}
+	if !grown.SharesStorageWith(sub) {
+		t.Error("expected shared storage")
+	}
+}
+
+func TestGet(t *testing.T) {
+	s := From("a", "b", "c")
+	if v, err := s.Get(2); err != nil || v != "c" {
+		t.Errorf(`got %q, %v; want "c", nil`, v, err)
+	}
+
+	var nilSlice *Slice[string]
+	cases := []struct {
+		s *Slice[string]
+		n int
+	}{
+		{s: s, n: 3},
+		{s: s, n: -1},
+		{s: nilSlice, n: 0},
+	}
+	for _, c := range cases {
+		v, err := c.s.Get(c.n)
+		if !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("Get(%d): got error %v, want ErrIndexOutOfRange", c.n, err)
+		}
+		if v != "" {
+			t.Errorf(`Get(%d): got %q, want ""`, c.n, v)
+		}
+	}
+}
@@ -0,0 +1,310 @@
+package slice
+
+import (
+	"cmp"
+	"slices"
+)
+
+// SortBy sorts s in place using a sequence of comparison functions.
+// Each one returns a negative number, zero, or a positive number
+// when a is less than, equal to, or greater than b.
+// Elements are ordered by the first key;
+// elements that compare equal on it are ordered by the second;
+// and so on.
+// The sort is stable,
+// so elements equal on all keys keep their original relative order.
+func SortBy[T any](s *Slice[T], keys ...func(a, b T) int) {
+	if s == nil {
+		return
+	}
+	slices.SortStableFunc(s.storage[s.offset:s.offset+s.length], func(a, b T) int {
+		for _, key := range keys {
+			if c := key(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+}
+
+// TopK returns the k largest elements of s, in descending order,
+// in a new slice.
+// If k exceeds s.Len(), all elements are returned.
+func TopK[T cmp.Ordered](s *Slice[T], k int) *Slice[T] {
+	return TopKFunc(s, k, cmp.Compare[T])
+}
+
+// TopKFunc is like TopK but orders elements with the comparison function cmp.
+//
+// It keeps the best k elements seen so far in a min-heap,
+// so the smallest of them is always at hand for eviction.
+// That makes it O(n log k) rather than the O(n log n) of a full sort.
+func TopKFunc[T any](s *Slice[T], k int, cmp func(a, b T) int) *Slice[T] {
+	k = max(0, min(k, s.Len()))
+	if k == 0 {
+		return nil
+	}
+
+	heap := make([]T, 0, k)
+	for i := 0; i < s.Len(); i++ {
+		v := s.At(i)
+		switch {
+		case len(heap) < k:
+			heap = append(heap, v)
+			siftUp(heap, len(heap)-1, cmp)
+		case cmp(v, heap[0]) > 0:
+			heap[0] = v
+			siftDown(heap, 0, cmp)
+		}
+	}
+
+	// Repeatedly remove the heap's minimum,
+	// filling the result from the back.
+	result := Make[T](k, k)
+	for i := k - 1; i >= 0; i-- {
+		result.storage[i] = heap[0]
+		heap[0] = heap[len(heap)-1]
+		heap = heap[:len(heap)-1]
+		siftDown(heap, 0, cmp)
+	}
+	return result
+}
+
+func siftUp[T any](heap []T, i int, cmp func(a, b T) int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if cmp(heap[i], heap[parent]) >= 0 {
+			return
+		}
+		heap[i], heap[parent] = heap[parent], heap[i]
+		i = parent
+	}
+}
+
+func siftDown[T any](heap []T, i int, cmp func(a, b T) int) {
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(heap) && cmp(heap[child], heap[smallest]) < 0 {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			return
+		}
+		heap[i], heap[smallest] = heap[smallest], heap[i]
+		i = smallest
+	}
+}
+
+// NthElement returns the element that would be at index k
+// if s were sorted.
+//
+// It uses quickselect,
+// which takes O(n) time on average
+// but partially reorders s in place as a side effect:
+// afterwards, s.At(k) is the returned element,
+// no element before index k is greater than it,
+// and no element after index k is less than it.
+func NthElement[T cmp.Ordered](s *Slice[T], k int) T {
+	return NthElementFunc(s, k, cmp.Compare[T])
+}
+
+// NthElementFunc is like NthElement but orders elements with the comparison function cmp.
+func NthElementFunc[T any](s *Slice[T], k int, cmp func(a, b T) int) T {
+	s.checkIndex(k)
+
+	var (
+		w      = s.storage[s.offset : s.offset+s.length]
+		lo, hi = 0, len(w)
+	)
+	for {
+		// Partition w[lo:hi] three ways around the pivot:
+		// w[lo:lt] is less than it,
+		// w[lt:gt] is equal to it,
+		// and w[gt:hi] is greater than it.
+		var (
+			pivot     = w[lo+(hi-lo)/2]
+			lt, i, gt = lo, lo, hi
+		)
+		for i < gt {
+			switch c := cmp(w[i], pivot); {
+			case c < 0:
+				w[lt], w[i] = w[i], w[lt]
+				lt++
+				i++
+			case c > 0:
+				gt--
+				w[i], w[gt] = w[gt], w[i]
+			default:
+				i++
+			}
+		}
+
+		switch {
+		case k < lt:
+			hi = lt
+		case k >= gt:
+			lo = gt
+		default:
+			return w[k]
+		}
+	}
+}
+
+// Rank returns the rank of each element of s
+// when the elements are ordered from largest to smallest:
+// the largest element has rank 1.
+// Equal elements share the same rank,
+// and the ranks after them are skipped
+// ("1224" or "competition" ranking).
+// The result parallels s:
+// element i of the result is the rank of element i of s.
+func Rank[T cmp.Ordered](s *Slice[T]) *Slice[int] {
+	return RankFunc(s, cmp.Compare[T])
+}
+
+// RankFunc is like Rank but orders elements with the comparison function cmp.
+func RankFunc[T any](s *Slice[T], cmp func(a, b T) int) *Slice[int] {
+	n := s.Len()
+	if n == 0 {
+		return nil
+	}
+
+	// Sort the indices of s so that they refer to its elements from largest to smallest.
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortFunc(order, func(i, j int) int { return cmp(s.At(j), s.At(i)) })
+
+	result := Make[int](n, n)
+	for k, i := range order {
+		if k > 0 && cmp(s.At(i), s.At(order[k-1])) == 0 {
+			result.storage[i] = result.storage[order[k-1]]
+		} else {
+			result.storage[i] = k + 1
+		}
+	}
+	return result
+}
+
+// Minima returns all the elements of s equal to its minimum,
+// in a new slice.
+// It panics if s is empty.
+func Minima[T cmp.Ordered](s *Slice[T]) *Slice[T] {
+	return MinimaFunc(s, cmp.Compare[T])
+}
+
+// Maxima returns all the elements of s equal to its maximum,
+// in a new slice.
+// It panics if s is empty.
+func Maxima[T cmp.Ordered](s *Slice[T]) *Slice[T] {
+	return MaximaFunc(s, cmp.Compare[T])
+}
+
+// MinimaFunc is like Minima but orders elements with the comparison function cmp.
+// The elements in the result are in the same order as in s.
+func MinimaFunc[T any](s *Slice[T], cmp func(a, b T) int) *Slice[T] {
+	if s.Len() == 0 {
+		panic("minima of empty slice")
+	}
+
+	result := From(s.At(0))
+	for i := 1; i < s.Len(); i++ {
+		v := s.At(i)
+		switch c := cmp(v, result.At(0)); {
+		case c < 0:
+			result = From(v)
+		case c == 0:
+			result = result.Append(v)
+		}
+	}
+	return result
+}
+
+// MaximaFunc is like Maxima but orders elements with the comparison function cmp.
+// The elements in the result are in the same order as in s.
+func MaximaFunc[T any](s *Slice[T], cmp func(a, b T) int) *Slice[T] {
+	if s.Len() == 0 {
+		panic("maxima of empty slice")
+	}
+	return MinimaFunc(s, func(a, b T) int { return cmp(b, a) })
+}
+
+// InsertSorted inserts v into s,
+// which must already be sorted in increasing order,
+// at the position that keeps it sorted.
+// If s already contains elements equal to v,
+// v goes after them.
+// The position is found by binary search.
+//
+// Like Append,
+// this uses s's spare capacity if there is enough
+// (shifting elements over within the storage)
+// and otherwise reallocates.
+func InsertSorted[T cmp.Ordered](s *Slice[T], v T) *Slice[T] {
+	return InsertSortedFunc(s, v, cmp.Compare[T])
+}
+
+// InsertSortedFunc is like InsertSorted but orders elements with the comparison function cmp.
+func InsertSortedFunc[T any](s *Slice[T], v T, cmp func(a, b T) int) *Slice[T] {
+	var (
+		i      = bisectRight(s, v, cmp)
+		result = s.Append(v)
+		w      = result.storage[result.offset : result.offset+result.length]
+	)
+	copy(w[i+1:], w[i:len(w)-1])
+	w[i] = v
+	return result
+}
+
+// bisectRight returns the index of the first element of s greater than v,
+// or s.Len() if there is none.
+func bisectRight[T any](s *Slice[T], v T, cmp func(a, b T) int) int {
+	lo, hi := 0, s.Len()
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		if cmp(s.At(mid), v) <= 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+// BisectLeft returns the leftmost index at which v could be inserted into s,
+// which must be sorted in increasing order,
+// keeping it sorted.
+// That is the index of the first element not less than v.
+// If s contains a run of elements equal to v,
+// this is the index of the start of the run.
+// (This is like bisect_left in Python's bisect module.)
+func BisectLeft[T cmp.Ordered](s *Slice[T], v T) int {
+	return bisectLeft(s, v, cmp.Compare[T])
+}
+
+// BisectRight is like BisectLeft
+// but returns the rightmost index at which v could be inserted:
+// the index of the first element greater than v.
+// If s contains a run of elements equal to v,
+// this is the index just past the end of the run.
+func BisectRight[T cmp.Ordered](s *Slice[T], v T) int {
+	return bisectRight(s, v, cmp.Compare[T])
+}
+
+// bisectLeft returns the index of the first element of s not less than v,
+// or s.Len() if there is none.
+func bisectLeft[T any](s *Slice[T], v T, cmp func(a, b T) int) int {
+	lo, hi := 0, s.Len()
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		if cmp(s.At(mid), v) < 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
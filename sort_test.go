@@ -0,0 +1,277 @@
+package slice
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestSortBy(t *testing.T) {
+	type person struct {
+		last, first string
+		age         int
+	}
+
+	s := From(
+		person{last: "Smith", first: "Zoe", age: 30},
+		person{last: "Jones", first: "Amy", age: 40},
+		person{last: "Smith", first: "Adam", age: 30},
+		person{last: "Jones", first: "Bob", age: 40},
+		person{last: "Smith", first: "Adam", age: 20},
+	)
+	SortBy(s,
+		func(a, b person) int { return cmp.Compare(a.last, b.last) },
+		func(a, b person) int { return cmp.Compare(a.first, b.first) },
+	)
+
+	want := []person{
+		{last: "Jones", first: "Amy", age: 40},
+		{last: "Jones", first: "Bob", age: 40},
+		{last: "Smith", first: "Adam", age: 30}, // stable: stays ahead of the other Adam Smith
+		{last: "Smith", first: "Adam", age: 20},
+		{last: "Smith", first: "Zoe", age: 30},
+	}
+	if got := elems(s); !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestTopK(t *testing.T) {
+	s := From(5, 1, 4, 1, 5, 9, 2, 6)
+
+	cases := []struct {
+		k    int
+		want []int
+	}{
+		{k: 0, want: nil},
+		{k: 3, want: []int{9, 6, 5}},
+		{k: 8, want: []int{9, 6, 5, 5, 4, 2, 1, 1}},
+		{k: 20, want: []int{9, 6, 5, 5, 4, 2, 1, 1}},
+	}
+	for _, c := range cases {
+		if got := elems(TopK(s, c.k)); !slices.Equal(got, c.want) {
+			t.Errorf("TopK(%d): got %v, want %v", c.k, got, c.want)
+		}
+	}
+}
+
+func TestTopKReference(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for trial := 0; trial < 100; trial++ {
+		var (
+			n     = r.IntN(50)
+			k     = r.IntN(60)
+			items = make([]int, n)
+		)
+		for i := range items {
+			items[i] = r.IntN(20)
+		}
+		got := elems(TopK(FromArray(slices.Clone(items)), k))
+
+		slices.SortFunc(items, func(a, b int) int { return b - a })
+		want := items[:min(k, n)]
+		if len(want) == 0 {
+			want = nil
+		}
+		if !slices.Equal(got, want) {
+			t.Fatalf("TopK(%v, %d): got %v, want %v", items, k, got, want)
+		}
+	}
+}
+
+func TestTopKFunc(t *testing.T) {
+	// Shortest first, by making "larger" mean shorter.
+	s := From("ccc", "a", "dddd", "bb")
+	got := TopKFunc(s, 2, func(a, b string) int { return cmp.Compare(len(b), len(a)) })
+	if !slices.Equal(elems(got), []string{"a", "bb"}) {
+		t.Errorf("got %v, want [a bb]", elems(got))
+	}
+}
+
+func TestNthElement(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for trial := 0; trial < 100; trial++ {
+		var (
+			n     = 1 + r.IntN(50)
+			k     = r.IntN(n)
+			items = make([]int, n)
+		)
+		for i := range items {
+			items[i] = r.IntN(20)
+		}
+		sorted := slices.Sorted(slices.Values(items))
+
+		s := FromArray(items)
+		if got := NthElement(s, k); got != sorted[k] {
+			t.Fatalf("NthElement(%v, %d): got %d, want %d", sorted, k, got, sorted[k])
+		}
+		if v := s.At(k); v != sorted[k] {
+			t.Errorf("after NthElement, element %d is %d, want %d", k, v, sorted[k])
+		}
+		for i := 0; i < k; i++ {
+			if s.At(i) > s.At(k) {
+				t.Errorf("element %d (%d) is greater than element %d (%d)", i, s.At(i), k, s.At(k))
+			}
+		}
+		for i := k + 1; i < n; i++ {
+			if s.At(i) < s.At(k) {
+				t.Errorf("element %d (%d) is less than element %d (%d)", i, s.At(i), k, s.At(k))
+			}
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	NthElement(From(1, 2, 3), 3)
+}
+
+func TestNthElementFunc(t *testing.T) {
+	s := From("ccc", "a", "dddd", "bb")
+	got := NthElementFunc(s, 1, func(a, b string) int { return cmp.Compare(len(a), len(b)) })
+	if got != "bb" {
+		t.Errorf(`got %q, want "bb"`, got)
+	}
+}
+
+func TestRank(t *testing.T) {
+	cases := []struct {
+		items, want []int
+	}{
+		{items: []int{10, 30, 20}, want: []int{3, 1, 2}},
+		{items: []int{50, 80, 80, 20, 50, 90}, want: []int{4, 2, 2, 6, 4, 1}},
+		{items: []int{7, 7, 7}, want: []int{1, 1, 1}},
+		{items: nil, want: nil},
+	}
+	for _, c := range cases {
+		if got := elems(Rank(FromArray(c.items))); !slices.Equal(got, c.want) {
+			t.Errorf("Rank(%v): got %v, want %v", c.items, got, c.want)
+		}
+	}
+}
+
+func TestRankFunc(t *testing.T) {
+	// Rank by length, shortest first.
+	s := From("ccc", "a", "bb", "dd")
+	got := RankFunc(s, func(a, b string) int { return cmp.Compare(len(b), len(a)) })
+	if want := []int{4, 1, 2, 2}; !slices.Equal(elems(got), want) {
+		t.Errorf("got %v, want %v", elems(got), want)
+	}
+}
+
+func TestMinimaMaxima(t *testing.T) {
+	s := From(3, 1, 4, 1, 5, 9, 2, 6, 5)
+	if got := elems(Minima(s)); !slices.Equal(got, []int{1, 1}) {
+		t.Errorf("got %v, want [1 1]", got)
+	}
+	if got := elems(Maxima(s)); !slices.Equal(got, []int{9}) {
+		t.Errorf("got %v, want [9]", got)
+	}
+
+	for _, f := range []func(*Slice[int]) *Slice[int]{Minima[int], Maxima[int]} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected a panic")
+				}
+			}()
+			f(nil)
+		}()
+	}
+}
+
+func TestMinimaMaximaFunc(t *testing.T) {
+	type item struct {
+		name  string
+		price int
+	}
+	var (
+		s = From(
+			item{"a", 5},
+			item{"b", 2},
+			item{"c", 7},
+			item{"d", 2},
+			item{"e", 7},
+		)
+		byPrice = func(x, y item) int { return cmp.Compare(x.price, y.price) }
+	)
+	if got := elems(MinimaFunc(s, byPrice)); !slices.Equal(got, []item{{"b", 2}, {"d", 2}}) {
+		t.Errorf("got %v, want [{b 2} {d 2}]", got)
+	}
+	if got := elems(MaximaFunc(s, byPrice)); !slices.Equal(got, []item{{"c", 7}, {"e", 7}}) {
+		t.Errorf("got %v, want [{c 7} {e 7}]", got)
+	}
+}
+
+func TestInsertSorted(t *testing.T) {
+	cases := []struct {
+		items []int
+		v     int
+		want  []int
+	}{
+		{items: []int{2, 4, 6}, v: 1, want: []int{1, 2, 4, 6}},
+		{items: []int{2, 4, 6}, v: 5, want: []int{2, 4, 5, 6}},
+		{items: []int{2, 4, 6}, v: 7, want: []int{2, 4, 6, 7}},
+		{items: nil, v: 3, want: []int{3}},
+	}
+	for _, c := range cases {
+		if got := elems(InsertSorted(FromArray(c.items), c.v)); !slices.Equal(got, c.want) {
+			t.Errorf("InsertSorted(%v, %d): got %v, want %v", c.items, c.v, got, c.want)
+		}
+	}
+
+	// Within capacity.
+	s := FromWithCap(4, 1, 3)
+	got := InsertSorted(s, 2)
+	if !slices.Equal(elems(got), []int{1, 2, 3}) {
+		t.Errorf("got %v, want [1 2 3]", elems(got))
+	}
+	if !got.SharesStorageWith(s) {
+		t.Error("inserting within capacity should not reallocate")
+	}
+}
+
+func TestInsertSortedFunc(t *testing.T) {
+	type item struct {
+		key  int
+		name string
+	}
+	var (
+		s     = From(item{1, "a"}, item{2, "b"}, item{2, "c"}, item{3, "d"})
+		byKey = func(x, y item) int { return cmp.Compare(x.key, y.key) }
+		got   = InsertSortedFunc(s, item{2, "new"}, byKey)
+		want  = []item{{1, "a"}, {2, "b"}, {2, "c"}, {2, "new"}, {3, "d"}}
+	)
+	if !slices.Equal(elems(got), want) {
+		t.Errorf("got %v, want %v", elems(got), want)
+	}
+}
+
+func TestBisect(t *testing.T) {
+	s := From(1, 2, 2, 2, 3, 5)
+	cases := []struct {
+		v, wantLeft, wantRight int
+	}{
+		{v: 2, wantLeft: 1, wantRight: 4},
+		{v: 0, wantLeft: 0, wantRight: 0},
+		{v: 1, wantLeft: 0, wantRight: 1},
+		{v: 4, wantLeft: 5, wantRight: 5},
+		{v: 5, wantLeft: 5, wantRight: 6},
+		{v: 9, wantLeft: 6, wantRight: 6},
+	}
+	for _, c := range cases {
+		if got := BisectLeft(s, c.v); got != c.wantLeft {
+			t.Errorf("BisectLeft(%d): got %d, want %d", c.v, got, c.wantLeft)
+		}
+		if got := BisectRight(s, c.v); got != c.wantRight {
+			t.Errorf("BisectRight(%d): got %d, want %d", c.v, got, c.wantRight)
+		}
+	}
+
+	if got := BisectLeft(nil, 3); got != 0 {
+		t.Errorf("got %d, want 0", got)
+	}
+}
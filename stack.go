@@ -0,0 +1,39 @@
+package slice
+
+// Stack is a last-in, first-out collection built on a Slice.
+// The zero value is an empty stack ready to use.
+type Stack[T any] struct {
+	items *Slice[T]
+}
+
+// Push adds v to the top of the stack.
+func (s *Stack[T]) Push(v T) {
+	s.items = s.items.Append(v)
+}
+
+// Pop removes and returns the element at the top of the stack.
+// It returns false if the stack is empty.
+func (s *Stack[T]) Pop() (T, bool) {
+	v, ok := s.Peek()
+	if ok {
+		s.items = s.items.Truncate(s.items.Len() - 1)
+	}
+	return v, ok
+}
+
+// Peek returns the element at the top of the stack without removing it.
+// It returns false if the stack is empty.
+func (s *Stack[T]) Peek() (T, bool) {
+	return s.items.TryAt(s.items.Len() - 1)
+}
+
+// Len is the number of elements in the stack.
+func (s *Stack[T]) Len() int {
+	return s.items.Len()
+}
+
+// Clear removes all elements from the stack.
+// The underlying storage is kept for reuse.
+func (s *Stack[T]) Clear() {
+	s.items = s.items.Truncate(0)
+}
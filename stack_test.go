@@ -0,0 +1,51 @@
+package slice
+
+import "testing"
+
+func TestStack(t *testing.T) {
+	var s Stack[string]
+
+	if _, ok := s.Pop(); ok {
+		t.Error("popping an empty stack should fail")
+	}
+	if _, ok := s.Peek(); ok {
+		t.Error("peeking at an empty stack should fail")
+	}
+
+	s.Push("a")
+	s.Push("b")
+	s.Push("c")
+	if l := s.Len(); l != 3 {
+		t.Errorf("got %d, want 3", l)
+	}
+	if v, ok := s.Peek(); !ok || v != "c" {
+		t.Errorf(`got %q, %v; want "c", true`, v, ok)
+	}
+
+	for _, want := range []string{"c", "b", "a"} {
+		if v, ok := s.Pop(); !ok || v != want {
+			t.Errorf("got %q, %v; want %q, true", v, ok, want)
+		}
+	}
+	if _, ok := s.Pop(); ok {
+		t.Error("popping an empty stack should fail")
+	}
+
+	s.Push("x")
+	s.Push("y")
+	s.Clear()
+	if l := s.Len(); l != 0 {
+		t.Errorf("got %d, want 0", l)
+	}
+}
+
+func TestStackPopZeroes(t *testing.T) {
+	var s Stack[*int]
+	s.Push(new(int))
+	s.Push(new(int))
+	items := s.items
+	s.Pop()
+	if p := items.At(1); p != nil {
+		t.Errorf("got %p, want nil", p)
+	}
+}
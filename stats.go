@@ -0,0 +1,226 @@
+package slice
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Median returns the median of the elements of s:
+// the middle element after sorting,
+// or the average of the two middle elements if s.Len() is even.
+// It panics if s is empty.
+//
+// The elements of s are not reordered.
+// Median works on a copy, using NthElement.
+func Median[T Number](s *Slice[T]) float64 {
+	n := s.Len()
+	if n == 0 {
+		panic("median of empty slice")
+	}
+
+	c := Make[T](n, n)
+	s.Copy(c)
+
+	upper := NthElement(c, n/2)
+	if n%2 == 1 {
+		return float64(upper)
+	}
+
+	// NthElement leaves everything smaller than upper before it,
+	// so the lower middle element is the largest of those.
+	lower := c.At(0)
+	for i := 1; i < n/2; i++ {
+		lower = max(lower, c.At(i))
+	}
+	return (float64(lower) + float64(upper)) / 2
+}
+
+// Percentile returns the p-th percentile of the elements of s,
+// for p between 0 and 100.
+// When that falls between two elements,
+// the result is interpolated linearly between them.
+// So the 0th percentile is the minimum,
+// the 100th is the maximum,
+// and the 50th is the median.
+// It panics if s is empty or p is out of range.
+//
+// The elements of s are not reordered.
+// Percentile sorts a copy.
+func Percentile[T Number](s *Slice[T], p float64) float64 {
+	n := s.Len()
+	if n == 0 {
+		panic("percentile of empty slice")
+	}
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		panic(fmt.Sprintf("percentile out of range: %v", p))
+	}
+
+	sorted := make([]T, n)
+	copy(sorted, s.storage[s.offset:s.offset+n])
+	slices.Sort(sorted)
+
+	var (
+		rank = p / 100 * float64(n-1)
+		lo   = int(math.Floor(rank))
+		hi   = int(math.Ceil(rank))
+		frac = rank - float64(lo)
+	)
+	return float64(sorted[lo]) + frac*(float64(sorted[hi])-float64(sorted[lo]))
+}
+
+// Histogram divides the range [lo, hi] into the given number of equal-width buckets
+// and counts how many elements of s fall into each one.
+// Each bucket includes its lower bound but not its upper bound,
+// except the last bucket, which also includes hi.
+// Elements outside [lo, hi] (including NaNs) are not counted.
+// It panics if buckets is not positive or if hi is not greater than lo.
+func Histogram[T Number](s *Slice[T], lo, hi T, buckets int) []int {
+	if buckets <= 0 {
+		panic("number of buckets must be positive")
+	}
+	if hi <= lo {
+		panic(fmt.Sprintf("invalid histogram range: %v >= %v", lo, hi))
+	}
+
+	var (
+		result = make([]int, buckets)
+		span   = float64(hi) - float64(lo)
+	)
+	for i := 0; i < s.Len(); i++ {
+		v := s.At(i)
+		// Written this way (rather than as v < lo || v > hi)
+		// so that NaN counts as out of range.
+		if !(v >= lo && v <= hi) {
+			continue
+		}
+		// Multiply before dividing.
+		// Dividing by a bucket width like 0.1,
+		// which floating point can't represent exactly,
+		// can put a value on a boundary into the bucket below.
+		b := min(int((float64(v)-float64(lo))*float64(buckets)/span), buckets-1)
+		result[b]++
+	}
+	return result
+}
+
+// MovingAverage returns the average of each run of window consecutive elements in s.
+// The result has s.Len()-window+1 elements
+// (or none, if window exceeds s.Len()).
+// It panics if window is not positive.
+func MovingAverage[T Number](s *Slice[T], window int) *Slice[float64] {
+	if window <= 0 {
+		panic("window size must be positive")
+	}
+	n := s.Len() - window + 1
+	if n <= 0 {
+		return nil
+	}
+
+	// Keep a running sum,
+	// adding each element as it enters the window
+	// and subtracting it as it leaves.
+	var sum float64
+	for i := 0; i < window; i++ {
+		sum += float64(s.At(i))
+	}
+	result := Make[float64](n, n)
+	result.storage[0] = sum / float64(window)
+	for i := 1; i < n; i++ {
+		sum += float64(s.At(i+window-1)) - float64(s.At(i-1))
+		result.storage[i] = sum / float64(window)
+	}
+	return result
+}
+
+// Differences returns the differences between consecutive elements of s:
+// element i of the result is s[i+1] - s[i].
+// The result has one element fewer than s
+// (or none, if s has fewer than two elements).
+func Differences[T Number](s *Slice[T]) *Slice[T] {
+	n := s.Len() - 1
+	if n <= 0 {
+		return nil
+	}
+	result := Make[T](n, n)
+	for i := 0; i < n; i++ {
+		result.storage[i] = s.At(i+1) - s.At(i)
+	}
+	return result
+}
+
+// Clamp changes each element of s in place
+// to lie within the range [lo, hi]:
+// elements less than lo become lo,
+// and elements greater than hi become hi.
+// It panics if lo > hi.
+func Clamp[T cmp.Ordered](s *Slice[T], lo, hi T) {
+	if lo > hi {
+		panic(fmt.Sprintf("invalid clamp range: %v > %v", lo, hi))
+	}
+	for i := 0; i < s.Len(); i++ {
+		p := s.AtPtr(i)
+		*p = min(max(*p, lo), hi)
+	}
+}
+
+// Normalize scales the elements of s in place so that they sum to 1,
+// as in a probability distribution.
+// If they sum to zero,
+// there is no way to do that,
+// and s is left unchanged.
+func Normalize(s *Slice[float64]) {
+	var sum float64
+	for i := 0; i < s.Len(); i++ {
+		sum += s.At(i)
+	}
+	if sum == 0 {
+		return
+	}
+	for i := 0; i < s.Len(); i++ {
+		*s.AtPtr(i) /= sum
+	}
+}
+
+// WeightedMovingAverage slides the kernel weights along s,
+// returning the weighted average of each window of weights.Len() consecutive elements:
+// element i of the result is the sum of s[i+j]*weights[j] for each j,
+// divided by the sum of the weights.
+// The result has s.Len()-weights.Len()+1 elements.
+// It panics if weights is empty, longer than s, or sums to zero.
+func WeightedMovingAverage(s *Slice[float64], weights *Slice[float64]) *Slice[float64] {
+	k := weights.Len()
+	if k == 0 {
+		panic("weights must not be empty")
+	}
+	if k > s.Len() {
+		panic(fmt.Sprintf("too many weights: %d > %d", k, s.Len()))
+	}
+
+	var total float64
+	for j := 0; j < k; j++ {
+		total += weights.At(j)
+	}
+	if total == 0 {
+		panic("weights must not sum to zero")
+	}
+
+	n := s.Len() - k + 1
+	result := Make[float64](n, n)
+	for i := 0; i < n; i++ {
+		var sum float64
+		for j := 0; j < k; j++ {
+			sum += s.At(i+j) * weights.At(j)
+		}
+		result.storage[i] = sum / total
+	}
+	return result
+}
@@ -0,0 +1,221 @@
+package slice
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestMedian(t *testing.T) {
+	cases := []struct {
+		items []int
+		want  float64
+	}{
+		{items: []int{7}, want: 7},
+		{items: []int{3, 1, 2}, want: 2},
+		{items: []int{4, 1, 3, 2}, want: 2.5},
+		{items: []int{5, 5, 1, 9, 5, 2}, want: 5},
+		{items: []int{10, 1, 7, 2}, want: 4.5},
+	}
+	for _, c := range cases {
+		var (
+			orig = slices.Clone(c.items)
+			s    = FromArray(c.items)
+		)
+		if got := Median(s); got != c.want {
+			t.Errorf("Median(%v): got %v, want %v", orig, got, c.want)
+		}
+		if !slices.Equal(c.items, orig) {
+			t.Errorf("Median changed its input from %v to %v", orig, c.items)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	Median[int](nil)
+}
+
+func TestPercentile(t *testing.T) {
+	var (
+		items = []int{40, 10, 30, 20, 50}
+		orig  = slices.Clone(items)
+		s     = FromArray(items)
+	)
+	cases := []struct {
+		p, want float64
+	}{
+		{p: 0, want: 10},
+		{p: 50, want: 30},
+		{p: 100, want: 50},
+		{p: 10, want: 14},
+		{p: 62.5, want: 35},
+	}
+	for _, c := range cases {
+		if got := Percentile(s, c.p); got != c.want {
+			t.Errorf("Percentile(%v): got %v, want %v", c.p, got, c.want)
+		}
+	}
+	if !slices.Equal(items, orig) {
+		t.Errorf("Percentile changed its input from %v to %v", orig, items)
+	}
+
+	for _, p := range []float64{-1, 101} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Percentile(%v): expected a panic", p)
+				}
+			}()
+			Percentile(s, p)
+		}()
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	s := Iterate(0, 100, func(x int) int { return x + 1 })
+	if got := Histogram(s, 0, 99, 3); !slices.Equal(got, []int{33, 33, 34}) {
+		t.Errorf("got %v, want [33 33 34]", got)
+	}
+
+	// Boundaries are 0, 2.5, 5, 7.5, 10.
+	f := From(-1, 0, 2.5, 2.4, 5, 7.5, 9.9, 10, 10.1)
+	if got := Histogram(f, 0, 10, 4); !slices.Equal(got, []int{2, 1, 1, 3}) {
+		t.Errorf("got %v, want [2 1 1 3]", got)
+	}
+
+	// Boundaries are multiples of 0.1, which is inexact in floating point.
+	g := From(0.3, 0.6, 0.7)
+	if got := Histogram(g, 0, 1, 10); !slices.Equal(got, []int{0, 0, 0, 1, 0, 0, 1, 1, 0, 0}) {
+		t.Errorf("got %v, want [0 0 0 1 0 0 1 1 0 0]", got)
+	}
+
+	h := From(1, math.NaN(), 2)
+	if got := Histogram(h, 0, 10, 5); !slices.Equal(got, []int{1, 1, 0, 0, 0}) {
+		t.Errorf("got %v, want [1 1 0 0 0]", got)
+	}
+
+	for _, c := range []struct{ lo, hi, buckets int }{{0, 10, 0}, {10, 10, 1}, {10, 0, 1}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Histogram(%d, %d, %d): expected a panic", c.lo, c.hi, c.buckets)
+				}
+			}()
+			Histogram(s, c.lo, c.hi, c.buckets)
+		}()
+	}
+}
+
+func TestMovingAverage(t *testing.T) {
+	s := From(1, 2, 6, 3, 8)
+
+	if got := elems(MovingAverage(s, 1)); !slices.Equal(got, []float64{1, 2, 6, 3, 8}) {
+		t.Errorf("got %v, want [1 2 6 3 8]", got)
+	}
+	if got := elems(MovingAverage(s, 2)); !slices.Equal(got, []float64{1.5, 4, 4.5, 5.5}) {
+		t.Errorf("got %v, want [1.5 4 4.5 5.5]", got)
+	}
+	if got := elems(MovingAverage(s, 5)); !slices.Equal(got, []float64{4}) {
+		t.Errorf("got %v, want [4]", got)
+	}
+	if l := MovingAverage(s, 6).Len(); l != 0 {
+		t.Errorf("got %d, want 0", l)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	MovingAverage(s, 0)
+}
+
+func TestDifferences(t *testing.T) {
+	cases := []struct {
+		items, want []int
+	}{
+		{items: []int{1, 3, 6, 10}, want: []int{2, 3, 4}},
+		{items: []int{10, 7, 7, 1}, want: []int{-3, 0, -6}},
+		{items: []int{5, 5, 5}, want: []int{0, 0}},
+		{items: []int{5}, want: nil},
+		{items: nil, want: nil},
+	}
+	for _, c := range cases {
+		if got := elems(Differences(FromArray(c.items))); !slices.Equal(got, c.want) {
+			t.Errorf("Differences(%v): got %v, want %v", c.items, got, c.want)
+		}
+	}
+}
+
+func TestClamp(t *testing.T) {
+	var (
+		parent = From(-5, -1, 0, 3, 7, 12, 99)
+		s      = parent.Subslice(1, 6)
+	)
+	Clamp(s, 0, 10)
+	if want := []int{-5, 0, 0, 3, 7, 10, 99}; !slices.Equal(elems(parent), want) {
+		t.Errorf("got %v, want %v", elems(parent), want)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	Clamp(s, 10, 0)
+}
+
+func TestNormalize(t *testing.T) {
+	s := From(1.0, 3.0, 4.0, 2.0)
+	Normalize(s)
+	if want := []float64{0.1, 0.3, 0.4, 0.2}; !EqualWithin(s, FromArray(want), 1e-12) {
+		t.Errorf("got %v, want %v", elems(s), want)
+	}
+	var sum float64
+	for i := 0; i < s.Len(); i++ {
+		sum += s.At(i)
+	}
+	if math.Abs(sum-1) > 1e-12 {
+		t.Errorf("got sum %v, want 1", sum)
+	}
+
+	s = From(0.0, 0.0, 0.0)
+	Normalize(s)
+	if want := []float64{0, 0, 0}; !slices.Equal(elems(s), want) {
+		t.Errorf("got %v, want %v", elems(s), want)
+	}
+
+	Normalize(nil)
+}
+
+func TestWeightedMovingAverage(t *testing.T) {
+	var (
+		s       = From(2.0, 4.0, 6.0, 8.0, 10.0)
+		weights = From(1.0, 2.0, 1.0)
+	)
+	// (2+8+6)/4, (4+12+8)/4, (6+16+10)/4
+	got := WeightedMovingAverage(s, weights)
+	if want := []float64{4, 6, 8}; !slices.Equal(elems(got), want) {
+		t.Errorf("got %v, want %v", elems(got), want)
+	}
+
+	// (2*3 + 4*1)/4, (4*3 + 6*1)/4, ...
+	got = WeightedMovingAverage(s, From(3.0, 1.0))
+	if want := []float64{2.5, 4.5, 6.5, 8.5}; !slices.Equal(elems(got), want) {
+		t.Errorf("got %v, want %v", elems(got), want)
+	}
+
+	for _, w := range []*Slice[float64]{nil, From(1.0, 1, 1, 1, 1, 1), From(1.0, -1)} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("weights %v: expected a panic", elems(w))
+				}
+			}()
+			WeightedMovingAverage(s, w)
+		}()
+	}
+}
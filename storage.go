@@ -0,0 +1,306 @@
+package slice
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SharesStorageWith tells whether s and other use the same underlying storage.
+// When they do,
+// changes made through one may be visible through the other,
+// even if their elements don't currently overlap:
+// an Append to one can write into its spare capacity,
+// which may be where the other's elements live.
+//
+// Two slices that can't reach any element of the storage
+// (because their capacity is zero)
+// share nothing.
+func (s *Slice[T]) SharesStorageWith(other *Slice[T]) bool {
+	if s.Cap() == 0 || other.Cap() == 0 {
+		return false
+	}
+	return &s.storage[0] == &other.storage[0]
+}
+
+// Overlaps tells whether s and other share some of the same elements:
+// that is, whether they use the same underlying storage
+// and their [offset, offset+length) windows within it intersect.
+// Unlike SharesStorageWith,
+// this is false for disjoint (or merely adjacent) windows
+// onto the same storage.
+func (s *Slice[T]) Overlaps(other *Slice[T]) bool {
+	if s.Len() == 0 || other.Len() == 0 || !s.SharesStorageWith(other) {
+		return false
+	}
+	return s.offset < other.offset+other.length && other.offset < s.offset+s.length
+}
+
+// Truncate is like s[:n],
+// but it also sets the dropped elements s[n:len(s)] to their zero value
+// in the underlying storage.
+// That way the storage does not keep alive anything those elements refer to.
+// (Go's intrinsic s[:n] leaves them in place.)
+func (s *Slice[T]) Truncate(n int) *Slice[T] {
+	if n < 0 {
+		panic("length must not be negative")
+	}
+	if n > s.Len() {
+		panic(fmt.Sprintf("length out of range: %d > %d", n, s.Len()))
+	}
+	s.Subslice(n, s.Len()).Clear()
+	return s.Subslice(0, n)
+}
+
+// Resize returns a slice of length n.
+// If n is less than s.Len(), this is Truncate(n).
+// Otherwise the slice is extended with zero values,
+// within s's capacity if possible,
+// or in newly allocated storage if not.
+func (s *Slice[T]) Resize(n int) *Slice[T] {
+	if n < 0 {
+		panic("length must not be negative")
+	}
+	if n <= s.Len() {
+		return s.Truncate(n)
+	}
+	if n <= s.Cap() {
+		// The spare capacity might hold leftover values,
+		// so zero it explicitly.
+		result := s.Subslice(0, n)
+		result.Subslice(s.Len(), n).Clear()
+		return result
+	}
+	return s.Append(make([]T, n-s.Len())...)
+}
+
+// PopN removes the last n elements from s.
+// It returns them, in a new slice,
+// and the remaining elements of s.
+// If n is negative it is treated as 0,
+// and if it exceeds s.Len() all of s is popped.
+//
+// As with Truncate,
+// the popped elements are zeroed in s's underlying storage.
+func (s *Slice[T]) PopN(n int) (popped, rest *Slice[T]) {
+	n = max(0, min(n, s.Len()))
+	if n > 0 {
+		popped = Make[T](n, n)
+		s.TakeLast(n).Copy(popped)
+	}
+	return popped, s.Truncate(s.Len() - n)
+}
+
+// DebugString describes the internal structure of s:
+// its offset into the underlying storage,
+// its length and capacity,
+// and the entire contents of the storage.
+// Elements of the storage outside s's window are shown in parentheses.
+// For example,
+//
+//	From("a", "b", "c", "d", "e").Subslice(1, 3).DebugString()
+//
+// produces
+//
+//	{off:1 len:2 cap:4 storage:[(a) b c (d) (e)]}
+func (s *Slice[T]) DebugString() string {
+	if s == nil {
+		return "{nil}"
+	}
+
+	buf := new(strings.Builder)
+	fmt.Fprintf(buf, "{off:%d len:%d cap:%d storage:[", s.offset, s.length, s.Cap())
+	for i, v := range s.storage {
+		if i > 0 {
+			buf.WriteByte(' ')
+		}
+		if i >= s.offset && i < s.offset+s.length {
+			fmt.Fprint(buf, v)
+		} else {
+			fmt.Fprintf(buf, "(%v)", v)
+		}
+	}
+	buf.WriteString("]}")
+	return buf.String()
+}
+
+// Clip is like s[:len(s):len(s)].
+// It returns a slice with the same elements as s
+// but no spare capacity,
+// so a subsequent Append must reallocate
+// rather than write into storage that other slices might be using.
+//
+// In Go's intrinsic slices,
+// capacity is a field of the slice header.
+// Here it is derived from the size of the storage,
+// so clipping means using a shorter view of the same storage.
+func (s *Slice[T]) Clip() *Slice[T] {
+	if s == nil {
+		return nil
+	}
+	return &Slice[T]{
+		storage: s.storage[:s.offset+s.length],
+		offset:  s.offset,
+		length:  s.length,
+	}
+}
+
+// Reset returns an empty slice that reuses s's underlying storage,
+// starting from the beginning of it,
+// so that subsequent Appends can fill it without reallocating.
+// The elements of s are zeroed first,
+// so the storage does not keep alive anything they refer to.
+//
+// Since the result starts at offset 0,
+// its capacity is that of the whole storage,
+// which is more than s.Cap() if s is itself a subslice at a nonzero offset.
+func (s *Slice[T]) Reset() *Slice[T] {
+	if s == nil {
+		return nil
+	}
+	s.Clear()
+	return &Slice[T]{storage: s.storage}
+}
+
+// CloneInto copies the elements of s into dest's underlying storage
+// and returns a slice of them,
+// like append(dest[:0], s...).
+// If dest's capacity is too small,
+// new storage is allocated instead.
+//
+// The result shares storage with s only if dest already does,
+// as in s.CloneInto(s.Subslice(0, 0)).
+// To get a copy that is independent of s,
+// make sure dest's storage is separate from s's
+// (or pass a nil dest).
+func (s *Slice[T]) CloneInto(dest *Slice[T]) *Slice[T] {
+	if dest.Cap() < s.Len() {
+		dest = Make[T](0, s.Len())
+	}
+	result := dest.Subslice(0, s.Len())
+	s.Copy(result)
+	return result
+}
+
+// AppendTo is like append(dest, s...).
+// It appends the elements of s to dest and returns the result.
+func (s *Slice[T]) AppendTo(dest *Slice[T]) *Slice[T] {
+	if s.Len() == 0 {
+		return dest
+	}
+	items := s.storage[s.offset : s.offset+s.length]
+	if dest == nil {
+		// Append would use items itself as the result's storage.
+		return fromCopy(items)
+	}
+	return dest.Append(items...)
+}
+
+// Extend is like append(s, make([]T, n)...).
+// It returns s lengthened by n zero-valued elements,
+// reallocating if s's capacity is too small.
+func (s *Slice[T]) Extend(n int) *Slice[T] {
+	if n < 0 {
+		panic("extension must not be negative")
+	}
+	return s.Resize(s.Len() + n)
+}
+
+// Shrink releases unused storage.
+// If s's underlying storage is more than twice the size of s
+// (counting both its spare capacity
+// and any storage before its offset),
+// Shrink copies the elements of s into new storage of exactly the right size
+// and returns that.
+// Otherwise it returns s unchanged,
+// since copying would cost more than it saves.
+//
+// Shrinking an empty slice produces nil.
+func (s *Slice[T]) Shrink() *Slice[T] {
+	if s == nil || len(s.storage) <= 2*s.length {
+		return s
+	}
+	if s.length == 0 {
+		return nil
+	}
+	result := Make[T](s.length, s.length)
+	s.Copy(result)
+	return result
+}
+
+// Prepend inserts items at the front of s and returns the result.
+// Go has no intrinsic counterpart,
+// but this one illustrates a use for the offset of a slice into its storage:
+// if s starts far enough into its storage,
+// the items are written into the space just before it,
+// and no reallocation is needed.
+// (Beware: that space may belong to other slices sharing the storage,
+// which will see the change.)
+// Otherwise new storage is allocated,
+// with spare capacity at the end as for Append.
+func (s *Slice[T]) Prepend(items ...T) *Slice[T] {
+	if len(items) == 0 {
+		return s
+	}
+	if s != nil && s.offset >= len(items) {
+		offset := s.offset - len(items)
+		copy(s.storage[offset:], items)
+		return &Slice[T]{
+			storage: s.storage,
+			offset:  offset,
+			length:  s.length + len(items),
+		}
+	}
+
+	var (
+		newLen = s.Len() + len(items)
+		result = Make[T](newLen, 2*newLen)
+	)
+	copy(result.storage, items)
+	s.Copy(result.Subslice(len(items), newLen))
+	return result
+}
+
+// AppendN appends n elements to s,
+// f(0) through f(n-1),
+// and returns the result.
+// Unlike a loop calling Append n times,
+// which may reallocate repeatedly as the slice grows,
+// AppendN reallocates at most once,
+// up front, to exactly the needed capacity.
+func (s *Slice[T]) AppendN(n int, f func(i int) T) *Slice[T] {
+	if n < 0 {
+		panic("count must not be negative")
+	}
+	if n == 0 {
+		return s
+	}
+
+	var result *Slice[T]
+	if s == nil || s.needsRealloc(n) {
+		result = Make[T](s.Len()+n, s.Len()+n)
+		s.Copy(result)
+	} else {
+		result = s.Subslice(0, s.Len()+n)
+	}
+	result.Subslice(s.Len(), s.Len()+n).FillWith(f)
+	return result
+}
+
+// Checkpoint returns a copy of s,
+// with its own storage,
+// to serve as a restore point.
+// Changes to s after the checkpoint don't affect it.
+// To go back to the checkpoint,
+// either use it in place of s
+// or, to keep using s's storage, call s.RestoreFrom(cp).
+func (s *Slice[T]) Checkpoint() *Slice[T] {
+	return s.CloneInto(nil)
+}
+
+// RestoreFrom copies the contents of the checkpoint cp back into s's storage
+// (reallocating if s's capacity is too small)
+// and returns the result.
+// It is the same as cp.CloneInto(s).
+func (s *Slice[T]) RestoreFrom(cp *Slice[T]) *Slice[T] {
+	return cp.CloneInto(s)
+}
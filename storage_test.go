@@ -0,0 +1,422 @@
+package slice
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSharesStorageWith(t *testing.T) {
+	var (
+		parent = From(1, 2, 3, 4, 5, 6)
+		a      = parent.Subslice(0, 4)
+		b      = parent.Subslice(2, 5)
+		c      = parent.Subslice(4, 6)
+		other  = From(1, 2, 3, 4, 5, 6)
+	)
+	if !a.SharesStorageWith(b) {
+		t.Error("overlapping subslices should share storage")
+	}
+	if !a.Subslice(0, 2).SharesStorageWith(c) {
+		t.Error("disjoint subslices of the same storage should share storage")
+	}
+	if parent.SharesStorageWith(other) {
+		t.Error("independent slices should not share storage")
+	}
+	if parent.SharesStorageWith(nil) {
+		t.Error("nil should share storage with nothing")
+	}
+	if parent.Subslice(6, 6).SharesStorageWith(parent) {
+		t.Error("a zero-capacity slice should share storage with nothing")
+	}
+}
+
+func TestOverlaps(t *testing.T) {
+	var (
+		parent = From(1, 2, 3, 4, 5, 6)
+		a      = parent.Subslice(0, 3)
+		b      = parent.Subslice(2, 5)
+		c      = parent.Subslice(3, 6)
+	)
+	if !a.Overlaps(b) || !b.Overlaps(a) {
+		t.Error("a and b should overlap")
+	}
+	if a.Overlaps(c) || c.Overlaps(a) {
+		t.Error("adjacent windows a and c should not overlap")
+	}
+	if !b.Overlaps(c) {
+		t.Error("b and c should overlap")
+	}
+	if a.Overlaps(From(1, 2, 3)) {
+		t.Error("independent slices should not overlap")
+	}
+	if a.Overlaps(parent.Subslice(1, 1)) {
+		t.Error("an empty window should overlap nothing")
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	var (
+		a, b, c = new(int), new(int), new(int)
+		s       = From(a, b, c)
+		got     = s.Truncate(1)
+	)
+	if l := got.Len(); l != 1 {
+		t.Errorf("got %d, want 1", l)
+	}
+	if p := got.At(0); p != a {
+		t.Errorf("got %p, want %p", p, a)
+	}
+	if p := s.At(1); p != nil {
+		t.Errorf("got %p, want nil", p)
+	}
+	if p := s.At(2); p != nil {
+		t.Errorf("got %p, want nil", p)
+	}
+
+	var nilSlice *Slice[*int]
+	if got := nilSlice.Truncate(0); got != nil {
+		t.Errorf("got %v, want nil", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	got.Truncate(2)
+}
+
+func TestResize(t *testing.T) {
+	s := From(1, 2, 3, 4, 5)
+
+	shrunk := s.Resize(2)
+	if got := elems(shrunk); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("got %v, want [1 2]", got)
+	}
+	if v := s.At(2); v != 0 {
+		t.Errorf("got %d, want 0", v)
+	}
+
+	s = From(1, 2, 3, 4, 5).Subslice(0, 2)
+	grown := s.Resize(4)
+	if got := elems(grown); !slices.Equal(got, []int{1, 2, 0, 0}) {
+		t.Errorf("got %v, want [1 2 0 0]", got)
+	}
+	if !grown.SharesStorageWith(s) {
+		t.Error("growing within capacity should not reallocate")
+	}
+
+	grown = s.Resize(8)
+	if got := elems(grown); !slices.Equal(got, []int{1, 2, 0, 0, 0, 0, 0, 0}) {
+		t.Errorf("got %v, want [1 2 0 0 0 0 0 0]", got)
+	}
+	if grown.SharesStorageWith(s) {
+		t.Error("growing beyond capacity should reallocate")
+	}
+}
+
+func TestPopN(t *testing.T) {
+	cases := []struct {
+		n                    int
+		wantPopped, wantRest []int
+	}{
+		{n: 0, wantRest: []int{1, 2, 3}},
+		{n: 2, wantPopped: []int{2, 3}, wantRest: []int{1}},
+		{n: 3, wantPopped: []int{1, 2, 3}},
+		{n: 4, wantPopped: []int{1, 2, 3}},
+	}
+	for _, c := range cases {
+		s := From(1, 2, 3)
+		popped, rest := s.PopN(c.n)
+		if got := elems(popped); !slices.Equal(got, c.wantPopped) {
+			t.Errorf("PopN(%d): got popped %v, want %v", c.n, got, c.wantPopped)
+		}
+		if got := elems(rest); !slices.Equal(got, c.wantRest) {
+			t.Errorf("PopN(%d): got rest %v, want %v", c.n, got, c.wantRest)
+		}
+		for i := rest.Len(); i < s.Len(); i++ {
+			if v := s.At(i); v != 0 {
+				t.Errorf("PopN(%d): element %d of s is %d, want 0", c.n, i, v)
+			}
+		}
+	}
+}
+
+func TestDebugString(t *testing.T) {
+	cases := []struct {
+		s    *Slice[string]
+		want string
+	}{
+		{s: From("a", "b", "c", "d", "e").Subslice(1, 3), want: "{off:1 len:2 cap:4 storage:[(a) b c (d) (e)]}"},
+		{s: From("a", "b", "c", "d", "e").Subslice(2, 5), want: "{off:2 len:3 cap:3 storage:[(a) (b) c d e]}"},
+		{s: Make[string](0, 2).Append("x"), want: "{off:0 len:1 cap:2 storage:[x ()]}"},
+		{s: nil, want: "{nil}"},
+	}
+	for _, c := range cases {
+		if got := c.s.DebugString(); got != c.want {
+			t.Errorf("got %s, want %s", got, c.want)
+		}
+	}
+}
+
+func TestClip(t *testing.T) {
+	var (
+		s       = From(1, 2, 3, 4, 5).Subslice(1, 3)
+		clipped = s.Clip()
+	)
+	if got := elems(clipped); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("got %v, want [2 3]", got)
+	}
+	if c := clipped.Cap(); c != 2 {
+		t.Errorf("got %d, want 2", c)
+	}
+	if !clipped.Overlaps(s) {
+		t.Error("Clip should not copy")
+	}
+	if appended := clipped.Append(9); appended.SharesStorageWith(s) {
+		t.Error("appending to a clipped slice should reallocate")
+	}
+}
+
+func TestReset(t *testing.T) {
+	var (
+		s     = Make[*int](0, 4).Append(new(int), new(int), new(int))
+		reset = s.Reset()
+	)
+	if l := reset.Len(); l != 0 {
+		t.Errorf("got %d, want 0", l)
+	}
+	if c := reset.Cap(); c != 4 {
+		t.Errorf("got %d, want 4", c)
+	}
+	for i := 0; i < s.Len(); i++ {
+		if p := s.At(i); p != nil {
+			t.Errorf("element %d is %p, want nil", i, p)
+		}
+	}
+
+	appended := reset.Append(new(int), new(int), new(int), new(int))
+	if !appended.SharesStorageWith(s) {
+		t.Error("Append after Reset should reuse the storage")
+	}
+
+	if got := From(1, 2, 3, 4).Subslice(2, 3).Reset().Cap(); got != 4 {
+		t.Errorf("got %d, want 4", got)
+	}
+}
+
+func TestCloneInto(t *testing.T) {
+	var (
+		s    = From(1, 2, 3)
+		dest = Make[int](1, 5)
+		got  = s.CloneInto(dest)
+	)
+	if !slices.Equal(elems(got), []int{1, 2, 3}) {
+		t.Errorf("got %v, want [1 2 3]", elems(got))
+	}
+	if !got.SharesStorageWith(dest) {
+		t.Error("CloneInto should reuse dest's storage when it is big enough")
+	}
+	if got.SharesStorageWith(s) {
+		t.Error("CloneInto result should not share storage with the source")
+	}
+
+	dest = Make[int](0, 2)
+	got = s.CloneInto(dest)
+	if !slices.Equal(elems(got), []int{1, 2, 3}) {
+		t.Errorf("got %v, want [1 2 3]", elems(got))
+	}
+	if got.SharesStorageWith(dest) || got.SharesStorageWith(s) {
+		t.Error("CloneInto should reallocate when dest is too small")
+	}
+
+	if got := s.CloneInto(nil); !slices.Equal(elems(got), []int{1, 2, 3}) {
+		t.Errorf("got %v, want [1 2 3]", elems(got))
+	}
+}
+
+func TestAppendTo(t *testing.T) {
+	var acc *Slice[string]
+	for _, s := range []*Slice[string]{From("a", "b"), nil, From("c"), From("d", "e", "f").Subslice(1, 3)} {
+		acc = s.AppendTo(acc)
+	}
+	if got := elems(acc); !slices.Equal(got, []string{"a", "b", "c", "e", "f"}) {
+		t.Errorf("got %v, want [a b c e f]", got)
+	}
+}
+
+func TestAppendToNil(t *testing.T) {
+	var (
+		s   = From(1, 2, 3)
+		got = s.AppendTo(nil)
+	)
+	if got.SharesStorageWith(s) {
+		t.Error("AppendTo a nil destination should copy")
+	}
+}
+
+func TestExtend(t *testing.T) {
+	var s *Slice[int]
+	s = s.Extend(2)
+	if got := elems(s); !slices.Equal(got, []int{0, 0}) {
+		t.Errorf("got %v, want [0 0]", got)
+	}
+
+	s = FromWithCap(5, 1, 2)
+	extended := s.Extend(2)
+	if got := elems(extended); !slices.Equal(got, []int{1, 2, 0, 0}) {
+		t.Errorf("got %v, want [1 2 0 0]", got)
+	}
+	if !extended.SharesStorageWith(s) {
+		t.Error("extending within capacity should not reallocate")
+	}
+
+	extended = s.Extend(4)
+	if got := elems(extended); !slices.Equal(got, []int{1, 2, 0, 0, 0, 0}) {
+		t.Errorf("got %v, want [1 2 0 0 0 0]", got)
+	}
+	if extended.SharesStorageWith(s) {
+		t.Error("extending beyond capacity should reallocate")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	s.Extend(-1)
+}
+
+func TestShrink(t *testing.T) {
+	s := FromWithCap(10, 1, 2, 3)
+	shrunk := s.Shrink()
+	if got := elems(shrunk); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("got %v, want [1 2 3]", got)
+	}
+	if c := shrunk.Cap(); c != 3 {
+		t.Errorf("got %d, want 3", c)
+	}
+	if shrunk.SharesStorageWith(s) {
+		t.Error("Shrink should have reallocated")
+	}
+
+	// Storage before the offset counts as waste too.
+	s = From(1, 2, 3, 4, 5, 6, 7, 8).Subslice(6, 8)
+	shrunk = s.Shrink()
+	if got := elems(shrunk); !slices.Equal(got, []int{7, 8}) {
+		t.Errorf("got %v, want [7 8]", got)
+	}
+	if shrunk.SharesStorageWith(s) {
+		t.Error("Shrink should have reallocated")
+	}
+
+	s = FromWithCap(5, 1, 2, 3)
+	if shrunk := s.Shrink(); shrunk != s {
+		t.Error("Shrink should have returned s unchanged")
+	}
+
+	if shrunk := Make[int](0, 10).Shrink(); shrunk != nil {
+		t.Errorf("got %v, want nil", shrunk.DebugString())
+	}
+}
+
+func TestPrepend(t *testing.T) {
+	var (
+		parent = From(0, 0, 0, 4, 5)
+		s      = parent.Subslice(3, 5)
+		got    = s.Prepend(2, 3)
+	)
+	if want := []int{2, 3, 4, 5}; !slices.Equal(elems(got), want) {
+		t.Errorf("got %v, want %v", elems(got), want)
+	}
+	if !got.Overlaps(s) {
+		t.Error("prepending into the space before the offset should not reallocate")
+	}
+
+	s = From(4, 5)
+	got = s.Prepend(1, 2, 3)
+	if want := []int{1, 2, 3, 4, 5}; !slices.Equal(elems(got), want) {
+		t.Errorf("got %v, want %v", elems(got), want)
+	}
+	if got.SharesStorageWith(s) {
+		t.Error("prepending onto a slice at offset 0 should reallocate")
+	}
+
+	var nilSlice *Slice[int]
+	if got := nilSlice.Prepend(1); !slices.Equal(elems(got), []int{1}) {
+		t.Errorf("got %v, want [1]", elems(got))
+	}
+}
+
+func TestAppendN(t *testing.T) {
+	var (
+		square = func(i int) int { return i * i }
+		s      = From(-1, -2)
+		want   = s
+	)
+	for i := 0; i < 100; i++ {
+		want = want.Append(square(i))
+	}
+
+	got := s.AppendN(100, square)
+	if !slices.Equal(elems(got), elems(want)) {
+		t.Errorf("got %v, want %v", elems(got), elems(want))
+	}
+	if c := got.Cap(); c != 102 {
+		t.Errorf("got capacity %d, want 102 (a single allocation)", c)
+	}
+
+	s = FromWithCap(10, 1, 2)
+	got = s.AppendN(3, square)
+	if !slices.Equal(elems(got), []int{1, 2, 0, 1, 4}) {
+		t.Errorf("got %v, want [1 2 0 1 4]", elems(got))
+	}
+	if !got.SharesStorageWith(s) {
+		t.Error("AppendN within capacity should not reallocate")
+	}
+
+	var nilSlice *Slice[int]
+	if got := nilSlice.AppendN(3, square); !slices.Equal(elems(got), []int{0, 1, 4}) {
+		t.Errorf("got %v, want [0 1 4]", elems(got))
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	s.AppendN(-1, square)
+}
+
+func TestCheckpoint(t *testing.T) {
+	var (
+		s  = From("a", "b", "c")
+		cp = s.Checkpoint()
+	)
+	if cp.SharesStorageWith(s) {
+		t.Error("a checkpoint should have its own storage")
+	}
+
+	*s.AtPtr(0) = "x"
+	s = s.Truncate(2)
+	if got := elems(cp); !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("checkpoint changed to %v", got)
+	}
+
+	restored := s.RestoreFrom(cp)
+	if got := elems(restored); !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("got %v, want [a b c]", got)
+	}
+	if !restored.SharesStorageWith(s) {
+		t.Error("RestoreFrom should reuse s's storage when it is big enough")
+	}
+
+	small := Make[string](0, 1)
+	restored = small.RestoreFrom(cp)
+	if got := elems(restored); !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("got %v, want [a b c]", got)
+	}
+	if restored.SharesStorageWith(small) || restored.SharesStorageWith(cp) {
+		t.Error("RestoreFrom should reallocate when s is too small")
+	}
+}
@@ -0,0 +1,74 @@
+package slice
+
+// DropLast is like s[:len(s)-n].
+// It returns all but the last n elements of s.
+// If n is negative it is treated as 0,
+// and if it exceeds s.Len() the result is empty.
+//
+// The result shares its underlying storage with s.
+func (s *Slice[T]) DropLast(n int) *Slice[T] {
+	n = max(0, min(n, s.Len()))
+	return s.Subslice(0, s.Len()-n)
+}
+
+// TakeLast is like s[len(s)-n:].
+// It returns the last n elements of s.
+// If n is negative it is treated as 0,
+// and if it exceeds s.Len() the result is all of s.
+//
+// The result shares its underlying storage with s.
+func (s *Slice[T]) TakeLast(n int) *Slice[T] {
+	n = max(0, min(n, s.Len()))
+	return s.Subslice(s.Len()-n, s.Len())
+}
+
+// SlidingWindowFunc calls f on each run of size consecutive elements of s,
+// in order, and returns the results in a new slice.
+// The result has s.Len()-size+1 elements
+// (or none, if size exceeds s.Len()).
+// It panics if size is not positive.
+//
+// Each window passed to f is a Subslice sharing storage with s.
+func SlidingWindowFunc[T, R any](s *Slice[T], size int, f func(*Slice[T]) R) *Slice[R] {
+	if size <= 0 {
+		panic("window size must be positive")
+	}
+	n := s.Len() - size + 1
+	if n <= 0 {
+		return nil
+	}
+	result := Make[R](n, n)
+	for i := 0; i < n; i++ {
+		result.storage[i] = f(s.Subslice(i, i+size))
+	}
+	return result
+}
+
+// ForEachChunk calls f on consecutive chunks of s,
+// each a Subslice of size elements
+// (except the last, which may be shorter).
+// If f returns an error,
+// ForEachChunk stops and returns that error.
+// It panics if size is not positive.
+func ForEachChunk[T any](s *Slice[T], size int, f func(*Slice[T]) error) error {
+	if size <= 0 {
+		panic("chunk size must be positive")
+	}
+	for i := 0; i < s.Len(); i += size {
+		if err := f(s.Subslice(i, min(i+size, s.Len()))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SplitAt is like (s[:i], s[i:]).
+// It returns the elements of s before index i
+// and the elements from index i on,
+// as two Subslices sharing storage with s.
+// If i is negative it is treated as 0,
+// and if it exceeds s.Len() it is treated as s.Len().
+func (s *Slice[T]) SplitAt(i int) (*Slice[T], *Slice[T]) {
+	i = max(0, min(i, s.Len()))
+	return s.Subslice(0, i), s.Subslice(i, s.Len())
+}
@@ -0,0 +1,173 @@
+package slice
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestDropLast(t *testing.T) {
+	s := From("a", "b", "c")
+
+	cases := []struct {
+		n    int
+		want []string
+	}{
+		{n: 0, want: []string{"a", "b", "c"}},
+		{n: 1, want: []string{"a", "b"}},
+		{n: 3, want: nil},
+		{n: 5, want: nil},
+	}
+	for _, c := range cases {
+		if got := elems(s.DropLast(c.n)); !slices.Equal(got, c.want) {
+			t.Errorf("DropLast(%d): got %v, want %v", c.n, got, c.want)
+		}
+	}
+}
+
+func TestTakeLast(t *testing.T) {
+	s := From("a", "b", "c")
+
+	cases := []struct {
+		n    int
+		want []string
+	}{
+		{n: 0, want: nil},
+		{n: 1, want: []string{"c"}},
+		{n: 3, want: []string{"a", "b", "c"}},
+		{n: 5, want: []string{"a", "b", "c"}},
+	}
+	for _, c := range cases {
+		if got := elems(s.TakeLast(c.n)); !slices.Equal(got, c.want) {
+			t.Errorf("TakeLast(%d): got %v, want %v", c.n, got, c.want)
+		}
+	}
+}
+
+func TestSlidingWindowFunc(t *testing.T) {
+	var (
+		s   = From(3, 1, 4, 1, 5, 9, 2)
+		sum = func(w *Slice[int]) int {
+			var total int
+			for i := 0; i < w.Len(); i++ {
+				total += w.At(i)
+			}
+			return total
+		}
+		largest = func(w *Slice[int]) int {
+			m := w.At(0)
+			for i := 1; i < w.Len(); i++ {
+				m = max(m, w.At(i))
+			}
+			return m
+		}
+	)
+
+	if got := elems(SlidingWindowFunc(s, 3, sum)); !slices.Equal(got, []int{8, 6, 10, 15, 16}) {
+		t.Errorf("got %v, want [8 6 10 15 16]", got)
+	}
+	if got := elems(SlidingWindowFunc(s, 3, largest)); !slices.Equal(got, []int{4, 4, 5, 9, 9}) {
+		t.Errorf("got %v, want [4 4 5 9 9]", got)
+	}
+	if got := elems(SlidingWindowFunc(s, 7, sum)); !slices.Equal(got, []int{25}) {
+		t.Errorf("got %v, want [25]", got)
+	}
+	if l := SlidingWindowFunc(s, 8, sum).Len(); l != 0 {
+		t.Errorf("got %d, want 0", l)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	SlidingWindowFunc(s, 0, sum)
+}
+
+func TestForEachChunk(t *testing.T) {
+	s := From(1, 2, 3, 4, 5, 6, 7)
+
+	cases := []struct {
+		size int
+		want [][]int
+	}{
+		{size: 7, want: [][]int{{1, 2, 3, 4, 5, 6, 7}}},
+		{size: 1, want: [][]int{{1}, {2}, {3}, {4}, {5}, {6}, {7}}},
+		{size: 3, want: [][]int{{1, 2, 3}, {4, 5, 6}, {7}}},
+		{size: 10, want: [][]int{{1, 2, 3, 4, 5, 6, 7}}},
+	}
+	for _, c := range cases {
+		var got [][]int
+		err := ForEachChunk(s, c.size, func(chunk *Slice[int]) error {
+			got = append(got, elems(chunk))
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.EqualFunc(got, c.want, slices.Equal) {
+			t.Errorf("size %d: got %v, want %v", c.size, got, c.want)
+		}
+	}
+}
+
+func TestForEachChunkError(t *testing.T) {
+	var (
+		s       = From(1, 2, 3, 4, 5, 6, 7)
+		errStop = errors.New("stop")
+		calls   int
+	)
+	err := ForEachChunk(s, 2, func(chunk *Slice[int]) error {
+		calls++
+		if chunk.At(0) == 3 {
+			return errStop
+		}
+		return nil
+	})
+	if !errors.Is(err, errStop) {
+		t.Errorf("got error %v, want %v", err, errStop)
+	}
+	if calls != 2 {
+		t.Errorf("got %d calls, want 2", calls)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	ForEachChunk(s, 0, func(*Slice[int]) error { return nil })
+}
+
+func TestSplitAt(t *testing.T) {
+	s := From("a", "b", "c", "d")
+
+	cases := []struct {
+		i           int
+		left, right []string
+	}{
+		{i: 0, right: []string{"a", "b", "c", "d"}},
+		{i: 1, left: []string{"a"}, right: []string{"b", "c", "d"}},
+		{i: 4, left: []string{"a", "b", "c", "d"}},
+		{i: -1, right: []string{"a", "b", "c", "d"}},
+		{i: 9, left: []string{"a", "b", "c", "d"}},
+	}
+	for _, c := range cases {
+		left, right := s.SplitAt(c.i)
+		if got := elems(left); !slices.Equal(got, c.left) {
+			t.Errorf("SplitAt(%d): got left %v, want %v", c.i, got, c.left)
+		}
+		if got := elems(right); !slices.Equal(got, c.right) {
+			t.Errorf("SplitAt(%d): got right %v, want %v", c.i, got, c.right)
+		}
+	}
+
+	left, right := s.SplitAt(2)
+	if !left.Overlaps(s) || !right.Overlaps(s) {
+		t.Error("both halves should share storage with s")
+	}
+	*right.AtPtr(0) = "x"
+	if v := s.At(2); v != "x" {
+		t.Errorf(`got %q, want "x"`, v)
+	}
+}